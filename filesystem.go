@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,6 +48,12 @@ func (os *Objectives) Set(o slo.Objective) {
 	os.mu.Unlock()
 }
 
+func (os *Objectives) Delete(o slo.Objective) {
+	os.mu.Lock()
+	delete(os.objectives, o.Labels.String())
+	os.mu.Unlock()
+}
+
 func (os *Objectives) Match(ms []*labels.Matcher) []slo.Objective {
 	if len(ms) == 0 {
 		os.mu.RLock()
@@ -98,10 +108,20 @@ func cmdFilesystem(logger log.Logger, reg *prometheus.Registry, promClient api.C
 		Name: "pyrra_filesystem_reconciles_errors_total",
 		Help: "The total amount of errors during reconciles.",
 	})
+	generationErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pyrra_objectives_generation_errors_total",
+		Help: "The total amount of errors generating the rules of an objective.",
+	}, []string{"name", "namespace"})
+	objectivesTotal := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "pyrra_objectives_total",
+		Help: "The amount of objectives with successfully generated rules.",
+	})
 
 	reg.MustRegister(
 		reconcilesTotal,
 		reconcilesErrors,
+		generationErrors,
+		objectivesTotal,
 	)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -113,13 +133,17 @@ func cmdFilesystem(logger log.Logger, reg *prometheus.Registry, promClient api.C
 	{
 		gr.Add(func() error {
 			// Initially read all files and send them to be processed and added to the in memory store.
-			filenames, err := filepath.Glob(configFiles)
+			filenames, err := globConfigFiles(configFiles)
 			if err != nil {
 				return fmt.Errorf("getting files names: %w", err)
 			}
 			for _, f := range filenames {
 				files <- f
 			}
+			// Rule files of config files deleted while Pyrra wasn't running are removed once on startup.
+			if err := removeStaleRuleFiles(logger, configFiles, prometheusFolder); err != nil {
+				level.Warn(logger).Log("msg", "failed to remove stale rule files", "err", err)
+			}
 			<-ctx.Done()
 			return nil
 		}, func(_ error) {
@@ -127,37 +151,27 @@ func cmdFilesystem(logger log.Logger, reg *prometheus.Registry, promClient api.C
 		})
 	}
 	{
-		dir := filepath.Dir(configFiles)
-		level.Info(logger).Log("msg", "watching directory for changes", "directory", dir)
-
 		watcher, err := fsnotify.NewWatcher()
 		if err != nil {
 			level.Error(logger).Log("msg", "failed to create file watcher", "err", err)
 			return 1
 		}
 
-		err = watcher.Add(dir)
+		dirs, err := configDirs(configFiles)
 		if err != nil {
-			level.Error(logger).Log("msg", "failed to add directory to file watcher", "directory", dir, "err", err)
+			level.Error(logger).Log("msg", "failed to get directories to watch", "err", err)
 			return 1
 		}
+		for _, dir := range dirs {
+			level.Info(logger).Log("msg", "watching directory for changes", "directory", dir)
+			if err := watcher.Add(dir); err != nil {
+				level.Error(logger).Log("msg", "failed to add directory to file watcher", "directory", dir, "err", err)
+				return 1
+			}
+		}
 
 		gr.Add(func() error {
-			for {
-				select {
-				case <-ctx.Done():
-					return nil
-				case event, ok := <-watcher.Events:
-					if !ok {
-						continue
-					}
-					if event.Op&fsnotify.Write == fsnotify.Write {
-						files <- event.Name
-					}
-				case err := <-watcher.Errors:
-					level.Warn(logger).Log("msg", "encountered file watcher error", "err", err)
-				}
-			}
+			return watchConfigFiles(ctx, logger, watcher, configFiles, files)
 		}, func(_ error) {
 			_ = watcher.Close()
 			cancel()
@@ -165,6 +179,9 @@ func cmdFilesystem(logger log.Logger, reg *prometheus.Registry, promClient api.C
 	}
 	{
 		gr.Add(func() error {
+			// loaded keeps the objective of each file to remove it once the file is changed or deleted.
+			loaded := map[string]slo.Objective{}
+
 			for {
 				select {
 				case <-ctx.Done():
@@ -176,21 +193,53 @@ func cmdFilesystem(logger log.Logger, reg *prometheus.Registry, promClient api.C
 						continue
 					}
 
+					if _, err := os.Stat(f); errors.Is(err, os.ErrNotExist) {
+						level.Info(logger).Log("msg", "removing objective of deleted file", "file", f)
+						if objective, ok := loaded[f]; ok {
+							objectives.Delete(objective)
+							delete(loaded, f)
+							objectivesTotal.Set(float64(len(loaded)))
+						}
+						path := ruleFilePath(configFiles, f, prometheusFolder)
+						if _, err := removeRuleFile(path); err != nil {
+							level.Warn(logger).Log("msg", "failed to remove rule file", "file", path, "err", err)
+						}
+						reload <- struct{}{} // Trigger a Prometheus reload
+						continue
+					}
+
 					level.Debug(logger).Log("msg", "processing", "file", f)
 					reconcilesTotal.Inc()
 
-					err := writeRuleFile(logger, f, prometheusFolder, genericRules, false)
+					// Invalid objectives are skipped to keep serving the previous objective and rules.
+					kubeObjective, objective, err := objectiveFromFile(f)
 					if err != nil {
 						reconcilesErrors.Inc()
-						level.Error(logger).Log("msg", "error creating rule file", "file", f, "err", err)
+						generationErrors.WithLabelValues(generationErrorLabels(f, kubeObjective)...).Inc()
+						level.Error(logger).Log("msg", "failed to get objective from file", "file", f, "err", err)
+						continue
 					}
 
-					_, objective, err := objectiveFromFile(f)
+					filenames, err := globConfigFiles(configFiles)
+					if err == nil {
+						err = checkRuleFilePath(configFiles, f, prometheusFolder, filenames)
+					}
+					if err == nil {
+						err = writeRuleFile(logger, f, ruleFilePath(configFiles, f, prometheusFolder), genericRules, false)
+					}
 					if err != nil {
 						reconcilesErrors.Inc()
-						level.Error(logger).Log("msg", "failed to get objective from file", "file", f, "err", err)
+						generationErrors.WithLabelValues(generationErrorLabels(f, kubeObjective)...).Inc()
+						level.Error(logger).Log("msg", "error creating rule file", "file", f, "err", err)
+						continue
+					}
+
+					if previous, ok := loaded[f]; ok && !labels.Equal(previous.Labels, objective.Labels) {
+						objectives.Delete(previous)
 					}
+					loaded[f] = objective
 					objectives.Set(objective)
+					objectivesTotal.Set(float64(len(loaded)))
 
 					reload <- struct{}{} // Trigger a Prometheus reload
 				}
@@ -272,6 +321,53 @@ func cmdFilesystem(logger log.Logger, reg *prometheus.Registry, promClient api.C
 	return 0
 }
 
+// configFilesDebounce is how long to wait for further changes before processing a changed file.
+// Editors often write files in multiple steps which should only be processed once.
+const configFilesDebounce = 500 * time.Millisecond
+
+// watchConfigFiles sends the files matching the pattern to files whenever they are created, changed or deleted.
+func watchConfigFiles(ctx context.Context, logger log.Logger, watcher *fsnotify.Watcher, pattern string, files chan<- string) error {
+	pending := map[string]struct{}{}
+	var debounce <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				continue
+			}
+			if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) && !event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) {
+				continue
+			}
+			if _, _, recursive := splitRecursivePattern(pattern); recursive && event.Has(fsnotify.Create) {
+				if fi, err := os.Stat(event.Name); err == nil && fi.IsDir() {
+					// New directories need to be watched too and may already contain files.
+					if err := watchNewDir(watcher, pattern, event.Name, pending); err != nil {
+						level.Warn(logger).Log("msg", "failed to watch new directory", "directory", event.Name, "err", err)
+					}
+					debounce = time.After(configFilesDebounce)
+					continue
+				}
+			}
+			if !matchConfigFile(pattern, event.Name) {
+				continue
+			}
+			pending[event.Name] = struct{}{}
+			debounce = time.After(configFilesDebounce)
+		case <-debounce:
+			for f := range pending {
+				files <- f
+			}
+			pending = map[string]struct{}{}
+			debounce = nil
+		case err := <-watcher.Errors:
+			level.Warn(logger).Log("msg", "encountered file watcher error", "err", err)
+		}
+	}
+}
+
 type FilesystemObjectiveServer struct {
 	objectives *Objectives
 }
@@ -301,38 +397,97 @@ func (s *FilesystemObjectiveServer) List(_ context.Context, req *connect.Request
 	}), nil
 }
 
-func writeRuleFile(logger log.Logger, file, prometheusFolder string, genericRules, operatorRule bool) error {
-	kubeObjective, objective, err := objectiveFromFile(file)
+// cmdFilesystemDryRun prints the rules generated for all config files instead of writing them.
+// All config files are generated, it returns 1 if any of them failed.
+func cmdFilesystemDryRun(logger log.Logger, out io.Writer, configFiles string, genericRules bool) int {
+	filenames, err := globConfigFiles(configFiles)
 	if err != nil {
-		return fmt.Errorf("failed to get objective: %w", err)
+		level.Error(logger).Log("msg", "getting file names", "err", err)
+		return 1
 	}
 
-	warn, err := kubeObjective.ValidateCreate()
-	if len(warn) > 0 {
-		for _, w := range warn {
-			level.Warn(logger).Log(
-				"msg", "validation warning",
-				"file", file,
-				"warning", w,
-			)
+	code := 0
+	for _, file := range filenames {
+		bytes, err := generateRules(logger, file, genericRules, false)
+		if err != nil {
+			level.Error(logger).Log("msg", "failed to generate rules", "file", file, "err", err)
+			code = 1
+			continue
+		}
+		if _, err := fmt.Fprintf(out, "---\n# Source: %s\n%s", file, bytes); err != nil {
+			level.Error(logger).Log("msg", "failed to print rules", "err", err)
+			return 1
 		}
 	}
+	return code
+}
+
+func writeRuleFile(logger log.Logger, file, path string, genericRules, operatorRule bool) error {
+	bytes, err := generateRules(logger, file, genericRules, operatorRule)
 	if err != nil {
-		return fmt.Errorf("invalid objective: %s - %w", file, err)
+		return err
 	}
 
-	increases, err := objective.IncreaseRules()
+	bytes = append([]byte(generatedRuleFileHeader), bytes...)
+
+	if err := os.WriteFile(path, bytes, 0o644); err != nil {
+		return fmt.Errorf("failed to write file %q: %w", path, err)
+	}
+	return nil
+}
+
+// generateRules returns the recording and alerting rules of the config file's objective as YAML.
+func generateRules(logger log.Logger, file string, genericRules, operatorRule bool) ([]byte, error) {
+	kubeObjective, _, rule, err := objectiveRules(logger, file, genericRules)
 	if err != nil {
-		return fmt.Errorf("failed to get increase rules: %w", err)
+		return nil, err
 	}
 
-	burnrates, err := objective.Burnrates()
+	bytes, err := yaml.Marshal(rule)
 	if err != nil {
-		return fmt.Errorf("failed to get burn rate rules: %w", err)
+		return nil, fmt.Errorf("failed to marshal rules: %w", err)
 	}
 
-	rule := monitoringv1.PrometheusRuleSpec{
-		Groups: []monitoringv1.RuleGroup{increases, burnrates},
+	if operatorRule {
+		monv1rule := &monitoringv1.PrometheusRule{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       monitoringv1.PrometheusRuleKind,
+				APIVersion: monitoring.GroupName + "/" + monitoringv1.Version,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      kubeObjective.GetName(),
+				Namespace: kubeObjective.GetNamespace(),
+				Labels:    kubeObjective.GetLabels(),
+			},
+			Spec: rule,
+		}
+
+		bytes, err = yaml.Marshal(monv1rule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal rules: %w", err)
+		}
+	}
+
+	return bytes, nil
+}
+
+// objectiveRules returns the validated objective of the config file and its recording and alerting rules.
+func objectiveRules(logger log.Logger, file string, genericRules bool) (v1alpha1.ServiceLevelObjective, slo.Objective, monitoringv1.PrometheusRuleSpec, error) {
+	kubeObjective, objective, err := objectiveFromFile(file)
+	if err != nil {
+		return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, monitoringv1.PrometheusRuleSpec{}, fmt.Errorf("failed to get objective: %w", err)
+	}
+
+	warn, rule, err := validatedRules(kubeObjective, objective)
+	for _, w := range warn {
+		level.Warn(logger).Log(
+			"msg", "validation warning",
+			"file", file,
+			"warning", w,
+		)
+	}
+	if err != nil {
+		return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, monitoringv1.PrometheusRuleSpec{}, fmt.Errorf("%s: %w", file, err)
 	}
 
 	if genericRules {
@@ -341,7 +496,7 @@ func writeRuleFile(logger log.Logger, file, prometheusFolder string, genericRule
 			rule.Groups = append(rule.Groups, rules)
 		} else {
 			if err != slo.ErrGroupingUnsupported {
-				return fmt.Errorf("failed to get generic rules: %w", err)
+				return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, monitoringv1.PrometheusRuleSpec{}, fmt.Errorf("failed to get generic rules: %w", err)
 			}
 			level.Warn(logger).Log(
 				"msg", "objective with grouping unsupported with generic rules",
@@ -350,55 +505,261 @@ func writeRuleFile(logger log.Logger, file, prometheusFolder string, genericRule
 		}
 	}
 
-	bytes, err := yaml.Marshal(rule)
+	return kubeObjective, objective, rule, nil
+}
+
+// validatedRules validates the objective like the Kubernetes webhook and returns its recording and alerting rules.
+// Validation warnings are returned even if the objective is invalid.
+func validatedRules(kubeObjective v1alpha1.ServiceLevelObjective, objective slo.Objective) ([]string, monitoringv1.PrometheusRuleSpec, error) {
+	warn, err := kubeObjective.ValidateCreate()
 	if err != nil {
-		return fmt.Errorf("failed to marshal rules: %w", err)
+		return warn, monitoringv1.PrometheusRuleSpec{}, fmt.Errorf("invalid objective: %w", err)
 	}
 
-	if operatorRule {
-		monv1rule := &monitoringv1.PrometheusRule{
-			TypeMeta: metav1.TypeMeta{
-				Kind:       monitoringv1.PrometheusRuleKind,
-				APIVersion: monitoring.GroupName + "/" + monitoringv1.Version,
-			},
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      kubeObjective.GetName(),
-				Namespace: kubeObjective.GetNamespace(),
-				Labels:    kubeObjective.GetLabels(),
-			},
-			Spec: rule,
+	increases, err := objective.IncreaseRules()
+	if err != nil {
+		return warn, monitoringv1.PrometheusRuleSpec{}, fmt.Errorf("failed to get increase rules: %w", err)
+	}
+
+	burnrates, err := objective.Burnrates()
+	if err != nil {
+		return warn, monitoringv1.PrometheusRuleSpec{}, fmt.Errorf("failed to get burn rate rules: %w", err)
+	}
+
+	return warn, monitoringv1.PrometheusRuleSpec{
+		Groups: []monitoringv1.RuleGroup{increases, burnrates},
+	}, nil
+}
+
+// generatedRuleFileHeader is the first line of all rule files Pyrra writes.
+// Only files starting with it are ever removed by Pyrra.
+const generatedRuleFileHeader = "# Code generated by Pyrra. DO NOT EDIT.\n"
+
+// removeRuleFile removes the rule file if it was generated by Pyrra.
+func removeRuleFile(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
 		}
+		return false, err
+	}
+	header := make([]byte, len(generatedRuleFileHeader))
+	_, err = io.ReadFull(f, header)
+	_ = f.Close()
+	if err != nil || string(header) != generatedRuleFileHeader {
+		return false, nil
+	}
 
-		bytes, err = yaml.Marshal(monv1rule)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	return true, nil
+}
+
+// removeStaleRuleFiles removes all rule files generated by Pyrra that have no config file anymore.
+func removeStaleRuleFiles(logger log.Logger, configFiles, prometheusFolder string) error {
+	filenames, err := globConfigFiles(configFiles)
+	if err != nil {
+		return fmt.Errorf("getting files names: %w", err)
+	}
+	expected := make(map[string]bool, len(filenames))
+	for _, f := range filenames {
+		expected[ruleFilePath(configFiles, f, prometheusFolder)] = true
+	}
+
+	entries, err := os.ReadDir(prometheusFolder)
+	if err != nil {
+		return fmt.Errorf("reading rule files: %w", err)
+	}
+	for _, e := range entries {
+		if e.IsDir() || (filepath.Ext(e.Name()) != ".yaml" && filepath.Ext(e.Name()) != ".yml") {
+			continue
+		}
+		path := filepath.Join(prometheusFolder, e.Name())
+		if expected[path] {
+			continue
+		}
+		removed, err := removeRuleFile(path)
 		if err != nil {
-			return fmt.Errorf("failed to marshal rules: %w", err)
+			return fmt.Errorf("removing stale rule file: %w", err)
+		}
+		if removed {
+			level.Info(logger).Log("msg", "removed stale rule file", "file", path)
 		}
 	}
+	return nil
+}
 
+// ruleFilePath returns the path of the rule file generated for a config file.
+// Files found by a recursive pattern are prefixed with their directories relative to the pattern's root,
+// e.g. team-a/slo.yaml is written to team-a_slo.yaml, to not collide with files of the same name.
+func ruleFilePath(pattern, file, prometheusFolder string) string {
+	if root, _, recursive := splitRecursivePattern(pattern); recursive {
+		if rel, err := filepath.Rel(root, file); err == nil {
+			return filepath.Join(prometheusFolder, strings.ReplaceAll(rel, string(filepath.Separator), "_"))
+		}
+	}
 	_, f := filepath.Split(file)
-	path := filepath.Join(prometheusFolder, f)
+	return filepath.Join(prometheusFolder, f)
+}
 
-	if err := os.WriteFile(path, bytes, 0o644); err != nil {
-		return fmt.Errorf("failed to write file %q: %w", path, err)
+// checkRuleFilePath returns an error if the rule file of another config file has the same path as file's.
+// Flattened nested paths can collide with other config files, e.g. team-a/slo.yaml with team-a_slo.yaml.
+func checkRuleFilePath(pattern, file, prometheusFolder string, filenames []string) error {
+	path := ruleFilePath(pattern, file, prometheusFolder)
+	for _, f := range filenames {
+		if f != file && ruleFilePath(pattern, f, prometheusFolder) == path {
+			return fmt.Errorf("rule file %s of %s collides with the rule file of %s", path, file, f)
+		}
 	}
 	return nil
 }
 
+// splitRecursivePattern splits a pattern at its ** element into the root directory and the pattern below it.
+func splitRecursivePattern(pattern string) (root, rest string, recursive bool) {
+	sep := string(filepath.Separator)
+	elems := strings.Split(pattern, sep)
+	for i, e := range elems {
+		if e != "**" {
+			continue
+		}
+		root = strings.Join(elems[:i], sep)
+		if root == "" && strings.HasPrefix(pattern, sep) {
+			root = sep
+		} else if root == "" {
+			root = "."
+		}
+		return root, strings.Join(elems[i+1:], sep), true
+	}
+	return "", "", false
+}
+
+// matchConfigFile returns whether the file matches the pattern.
+// A ** element matches any number of directories, e.g. /etc/pyrra/**/*.yaml matches /etc/pyrra/team/slo.yaml.
+func matchConfigFile(pattern, file string) bool {
+	root, rest, recursive := splitRecursivePattern(pattern)
+	if !recursive {
+		matched, _ := filepath.Match(pattern, file)
+		return matched
+	}
+
+	rel, err := filepath.Rel(root, file)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return false
+	}
+	if rest == "" {
+		return true
+	}
+
+	// The pattern below ** has to match the same number of trailing path elements.
+	relElems := strings.Split(rel, string(filepath.Separator))
+	restElems := strings.Split(rest, string(filepath.Separator))
+	if len(relElems) < len(restElems) {
+		return false
+	}
+	matched, _ := filepath.Match(rest, filepath.Join(relElems[len(relElems)-len(restElems):]...))
+	return matched
+}
+
+// globConfigFiles returns all files matching the pattern.
+// Unlike filepath.Glob it supports recursive patterns, see matchConfigFile.
+func globConfigFiles(pattern string) ([]string, error) {
+	root, rest, recursive := splitRecursivePattern(pattern)
+	if !recursive {
+		return filepath.Glob(pattern)
+	}
+	if _, err := filepath.Match(rest, ""); err != nil {
+		return nil, err
+	}
+
+	var files []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && matchConfigFile(pattern, path) {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+// configDirs returns the directories to watch for changes of files matching the pattern.
+func configDirs(pattern string) ([]string, error) {
+	root, _, recursive := splitRecursivePattern(pattern)
+	if !recursive {
+		return []string{filepath.Dir(pattern)}, nil
+	}
+
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	return dirs, err
+}
+
+// watchNewDir adds a newly created directory and its subdirectories to the watcher.
+// Files matching the pattern that already exist are added to pending.
+func watchNewDir(watcher *fsnotify.Watcher, pattern, dir string, pending map[string]struct{}) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return watcher.Add(path)
+		}
+		if matchConfigFile(pattern, path) {
+			pending[path] = struct{}{}
+		}
+		return nil
+	})
+}
+
 func objectiveFromFile(file string) (v1alpha1.ServiceLevelObjective, slo.Objective, error) {
 	bytes, err := os.ReadFile(file)
 	if err != nil {
 		return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, fmt.Errorf("failed to read file %q: %w", file, err)
 	}
 
+	config, objective, err := parseObjective(bytes)
+	if err != nil {
+		return config, slo.Objective{}, fmt.Errorf("%q: %w", file, err)
+	}
+	return config, objective, nil
+}
+
+// generationErrorLabels returns the name and namespace labels counting the generation errors of a config file.
+// The name falls back to the file's name for configs that couldn't be read or unmarshaled.
+func generationErrorLabels(file string, config v1alpha1.ServiceLevelObjective) []string {
+	name := config.GetName()
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+	}
+	return []string{name, config.GetNamespace()}
+}
+
+// parseObjective strictly unmarshals the YAML or JSON config of an objective.
+// The config is returned even if it isn't a valid objective, e.g. to know its name.
+func parseObjective(bytes []byte) (v1alpha1.ServiceLevelObjective, slo.Objective, error) {
 	var config v1alpha1.ServiceLevelObjective
 	if err := yaml.UnmarshalStrict(bytes, &config); err != nil {
-		return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, fmt.Errorf("failed to unmarshal objective %q: %w", file, err)
+		return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, fmt.Errorf("failed to unmarshal objective: %w", err)
 	}
 
 	objective, err := config.Internal()
 	if err != nil {
-		return v1alpha1.ServiceLevelObjective{}, slo.Objective{}, fmt.Errorf("failed to get objective %q: %w", file, err)
+		return config, slo.Objective{}, fmt.Errorf("failed to get objective: %w", err)
 	}
+	// Keep the config as is, including comments, instead of the re-marshaled config.
+	objective.Config = string(bytes)
 
 	return config, objective, nil
 }
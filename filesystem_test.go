@@ -1,8 +1,16 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-kit/log"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/stretchr/testify/require"
 
@@ -56,3 +64,159 @@ func TestMatchObjectives(t *testing.T) {
 	require.Contains(t, matches, obj3)
 	require.Contains(t, matches, obj4)
 }
+
+func TestWatchConfigFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	watcher, err := fsnotify.NewWatcher()
+	require.NoError(t, err)
+	defer watcher.Close()
+	require.NoError(t, watcher.Add(dir))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	files := make(chan string, 16)
+	go func() {
+		_ = watchConfigFiles(ctx, log.NewNopLogger(), watcher, filepath.Join(dir, "*.yaml"), files)
+	}()
+
+	file := filepath.Join(dir, "slo.yaml")
+	for i := 0; i < 3; i++ {
+		require.NoError(t, os.WriteFile(file, []byte(fmt.Sprintf("# %d", i)), 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored.txt"), []byte("ignored"), 0o644))
+
+	select {
+	case f := <-files:
+		require.Equal(t, file, f)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for changed file")
+	}
+
+	// All writes are debounced into a single change.
+	select {
+	case f := <-files:
+		t.Fatalf("unexpected change of %s", f)
+	case <-time.After(2 * configFilesDebounce):
+	}
+
+	require.NoError(t, os.Remove(file))
+	select {
+	case f := <-files:
+		require.Equal(t, file, f)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for deleted file")
+	}
+}
+
+func TestGlobConfigFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, f := range []string{"a.yaml", "b.txt", "team-a/slo.yaml", "team-b/slo.yaml", "team-b/nested/c.yaml"} {
+		path := filepath.Join(dir, f)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, nil, 0o644))
+	}
+
+	files, err := globConfigFiles(filepath.Join(dir, "*.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "a.yaml")}, files)
+
+	pattern := filepath.Join(dir, "**", "*.yaml")
+	files, err = globConfigFiles(pattern)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "a.yaml"),
+		filepath.Join(dir, "team-a/slo.yaml"),
+		filepath.Join(dir, "team-b/nested/c.yaml"),
+		filepath.Join(dir, "team-b/slo.yaml"),
+	}, files)
+
+	files, err = globConfigFiles(filepath.Join(dir, "**", "nested", "*.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "team-b/nested/c.yaml")}, files)
+
+	require.False(t, matchConfigFile(pattern, "/somewhere/else/slo.yaml"))
+
+	require.Equal(t, "/rules/a.yaml", ruleFilePath(pattern, filepath.Join(dir, "a.yaml"), "/rules"))
+	require.Equal(t, "/rules/team-a_slo.yaml", ruleFilePath(pattern, filepath.Join(dir, "team-a/slo.yaml"), "/rules"))
+	require.Equal(t, "/rules/team-b_slo.yaml", ruleFilePath(pattern, filepath.Join(dir, "team-b/slo.yaml"), "/rules"))
+	require.Equal(t, "/rules/slo.yaml", ruleFilePath(filepath.Join(dir, "team-a", "*.yaml"), filepath.Join(dir, "team-a/slo.yaml"), "/rules"))
+
+	nested, flat := filepath.Join(dir, "team-a/slo.yaml"), filepath.Join(dir, "team-a_slo.yaml")
+	require.NoError(t, checkRuleFilePath(pattern, nested, "/rules", []string{filepath.Join(dir, "a.yaml"), nested}))
+	require.EqualError(t,
+		checkRuleFilePath(pattern, nested, "/rules", []string{nested, flat}),
+		fmt.Sprintf("rule file /rules/team-a_slo.yaml of %s collides with the rule file of %s", nested, flat),
+	)
+}
+
+func TestRemoveStaleRuleFiles(t *testing.T) {
+	configDir, rulesDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "current.yaml"), nil, 0o644))
+
+	for name, content := range map[string]string{
+		"current.yaml": generatedRuleFileHeader + "groups: []\n",
+		"deleted.yaml": generatedRuleFileHeader + "groups: []\n",
+		"custom.yaml":  "groups: []\n",
+		"deleted.txt":  generatedRuleFileHeader,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(rulesDir, name), []byte(content), 0o644))
+	}
+
+	require.NoError(t, removeStaleRuleFiles(log.NewNopLogger(), filepath.Join(configDir, "*.yaml"), rulesDir))
+
+	entries, err := os.ReadDir(rulesDir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	require.Equal(t, []string{"current.yaml", "custom.yaml", "deleted.txt"}, names)
+}
+
+func TestFilesystemDryRun(t *testing.T) {
+	dir := t.TempDir()
+	config, err := os.ReadFile("examples/pyrra-filesystem-errors.yaml")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "valid.yaml"), config, 0o644))
+
+	var out strings.Builder
+	require.Equal(t, 0, cmdFilesystemDryRun(log.NewNopLogger(), &out, filepath.Join(dir, "*.yaml"), false))
+	require.Contains(t, out.String(), "# Source: "+filepath.Join(dir, "valid.yaml"))
+	require.Contains(t, out.String(), "groups:")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.yaml"), []byte("spec: {}\n"), 0o644))
+	out.Reset()
+	require.Equal(t, 1, cmdFilesystemDryRun(log.NewNopLogger(), &out, filepath.Join(dir, "*.yaml"), false))
+	require.Contains(t, out.String(), "# Source: "+filepath.Join(dir, "valid.yaml"))
+}
+
+func TestGenerationErrorLabels(t *testing.T) {
+	dir := t.TempDir()
+
+	invalid := filepath.Join(dir, "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte(`apiVersion: pyrra.dev/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: http-errors
+  namespace: monitoring
+spec:
+  target: foo
+  window: 4w
+`), 0o644))
+	config, _, err := objectiveFromFile(invalid)
+	require.Error(t, err)
+	require.Equal(t, []string{"http-errors", "monitoring"}, generationErrorLabels(invalid, config))
+
+	unparsable := filepath.Join(dir, "unparsable.yaml")
+	require.NoError(t, os.WriteFile(unparsable, []byte("{"), 0o644))
+	config, _, err = objectiveFromFile(unparsable)
+	require.Error(t, err)
+	require.Equal(t, []string{"unparsable", ""}, generationErrorLabels(unparsable, config))
+
+	missing := filepath.Join(dir, "missing.yml")
+	config, _, err = objectiveFromFile(missing)
+	require.Error(t, err)
+	require.Equal(t, []string{"missing", ""}, generationErrorLabels(missing, config))
+}
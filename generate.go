@@ -14,25 +14,52 @@ limitations under the License.
 package main
 
 import (
-	"path/filepath"
+	"fmt"
+	"io"
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 )
 
 func cmdGenerate(logger log.Logger, configFiles, prometheusFolder string, genericRules, operatorRule bool) int {
-	filenames, err := filepath.Glob(configFiles)
+	filenames, err := globConfigFiles(configFiles)
 	if err != nil {
 		level.Error(logger).Log("msg", "getting file names", "err", err)
 		return 1
 	}
 
 	for _, file := range filenames {
-		err := writeRuleFile(logger, file, prometheusFolder, genericRules, operatorRule)
+		if err := checkRuleFilePath(configFiles, file, prometheusFolder, filenames); err != nil {
+			level.Error(logger).Log("msg", "generating rule files", "err", err)
+			return 1
+		}
+	}
+
+	for _, file := range filenames {
+		err := writeRuleFile(logger, file, ruleFilePath(configFiles, file, prometheusFolder), genericRules, operatorRule)
 		if err != nil {
 			level.Error(logger).Log("msg", "generating rule files", "err", err)
 			return 1
 		}
 	}
+
+	if err := removeStaleRuleFiles(logger, configFiles, prometheusFolder); err != nil {
+		level.Error(logger).Log("msg", "removing stale rule files", "err", err)
+		return 1
+	}
+	return 0
+}
+
+// cmdGenerateConfig prints the rules generated for a single config file instead of writing a rule file.
+func cmdGenerateConfig(logger log.Logger, out io.Writer, configFile string, genericRules, operatorRule bool) int {
+	bytes, err := generateRules(logger, configFile, genericRules, operatorRule)
+	if err != nil {
+		level.Error(logger).Log("msg", "failed to generate rules", "file", configFile, "err", err)
+		return 1
+	}
+	if _, err := fmt.Fprintf(out, "%s", bytes); err != nil {
+		level.Error(logger).Log("msg", "failed to print rules", "err", err)
+		return 1
+	}
 	return 0
 }
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateConfig(t *testing.T) {
+	var out strings.Builder
+	require.Equal(t, 0, cmdGenerateConfig(log.NewNopLogger(), &out, "examples/pyrra-filesystem-errors.yaml", false, false))
+	require.True(t, strings.HasPrefix(out.String(), "groups:\n"), out.String())
+
+	out.Reset()
+	require.Equal(t, 0, cmdGenerateConfig(log.NewNopLogger(), &out, "examples/pyrra-filesystem-errors.yaml", false, true))
+	require.Contains(t, out.String(), "kind: PrometheusRule\n")
+
+	invalid := filepath.Join(t.TempDir(), "invalid.yaml")
+	require.NoError(t, os.WriteFile(invalid, []byte("spec: {}\n"), 0o644))
+	out.Reset()
+	require.Equal(t, 1, cmdGenerateConfig(log.NewNopLogger(), &out, invalid, false, false))
+	require.Empty(t, out.String())
+
+	require.Equal(t, 1, cmdGenerateConfig(log.NewNopLogger(), &out, filepath.Join(t.TempDir(), "missing.yaml"), false, false))
+}
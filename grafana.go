@@ -0,0 +1,230 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	prometheusapiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	objectivesv1alpha1 "github.com/pyrra-dev/pyrra/proto/objectives/v1alpha1"
+	"github.com/pyrra-dev/pyrra/slo"
+)
+
+// grafanaServer implements the Grafana SimpleJSON datasource protocol.
+// Targets are the objectives' label selectors, for example {__name__="api", namespace="default"}.
+// Each target returns the objective's error budget and its burn rates.
+type grafanaServer struct {
+	logger     log.Logger
+	objectives *objectiveServer
+}
+
+func (gs *grafanaServer) routes() http.Handler {
+	r := chi.NewRouter()
+	// Grafana tests the connection to the datasource with a request to the root.
+	r.Get("/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	r.Post("/search", gs.search)
+	r.Post("/query", gs.query)
+	return r
+}
+
+type grafanaSearchRequest struct {
+	Target string `json:"target"`
+}
+
+func (gs *grafanaServer) search(w http.ResponseWriter, r *http.Request) {
+	var req grafanaSearchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := gs.objectives.client.List(r.Context(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	if err != nil {
+		gs.error(w, err)
+		return
+	}
+
+	targets := make([]string, 0, len(resp.Msg.Objectives))
+	for _, o := range resp.Msg.Objectives {
+		objective := objectivesv1alpha1.ToInternal(o)
+		if !strings.Contains(objective.Name(), req.Target) {
+			continue
+		}
+		targets = append(targets, objective.Labels.String())
+	}
+	sort.Strings(targets)
+
+	writeGrafanaResponse(w, targets)
+}
+
+type grafanaQueryRequest struct {
+	Range struct {
+		From time.Time `json:"from"`
+		To   time.Time `json:"to"`
+	} `json:"range"`
+	IntervalMs int64 `json:"intervalMs"`
+	Targets    []struct {
+		Target string `json:"target"`
+	} `json:"targets"`
+}
+
+type grafanaTimeseries struct {
+	Target     string       `json:"target"`
+	Datapoints [][2]float64 `json:"datapoints"`
+}
+
+func (gs *grafanaServer) query(w http.ResponseWriter, r *http.Request) {
+	var req grafanaQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.Range.From.IsZero() || req.Range.To.IsZero() || !req.Range.From.Before(req.Range.To) {
+		http.Error(w, "invalid range", http.StatusBadRequest)
+		return
+	}
+
+	start, end := req.Range.From, req.Range.To
+	step := gs.objectives.queryStep(start, end)
+	if interval := time.Duration(req.IntervalMs) * time.Millisecond; interval > step {
+		step = interval
+	}
+	promRange := prometheusapiv1.Range{Start: start, End: end, Step: step}
+
+	timeseries := []grafanaTimeseries{}
+	for _, t := range req.Targets {
+		if t.Target == "" {
+			continue
+		}
+
+		objective, err := gs.objectives.getObjective(r.Context(), "/grafana/query", t.Target)
+		if err != nil {
+			gs.error(w, err)
+			return
+		}
+
+		query := objective.QueryErrorBudget()
+		value, _, err := gs.objectives.promAPI.QueryRange(contextSetPromCache(r.Context(), 15*time.Second), query, promRange)
+		if err != nil {
+			gs.error(w, connect.NewError(queryErrorCode(err), gs.objectives.exposeQuery(query, err)))
+			return
+		}
+		timeseries = append(timeseries, grafanaMatrix(objective, "error budget", value)...)
+
+		for _, window := range burnrateWindows(objective) {
+			query, err := objective.QueryBurnrate(window, nil)
+			if err != nil {
+				gs.error(w, connect.NewError(connect.CodeInternal, err))
+				return
+			}
+			value, _, err := gs.objectives.promAPI.QueryRange(contextSetPromCache(r.Context(), rangeCache(start, end)), query, promRange)
+			if err != nil {
+				gs.error(w, connect.NewError(queryErrorCode(err), gs.objectives.exposeQuery(query, err)))
+				return
+			}
+			timeseries = append(timeseries, grafanaMatrix(objective, "burnrate "+model.Duration(window).String(), value)...)
+		}
+	}
+
+	writeGrafanaResponse(w, timeseries)
+}
+
+func (gs *grafanaServer) error(w http.ResponseWriter, err error) {
+	httpError(w, gs.logger, "failed to handle Grafana request", err)
+}
+
+// statusClientClosedRequest is nginx's non-standard status code for requests canceled by the client.
+// The client never sees it, but it tells canceled requests apart in metrics and logs.
+const statusClientClosedRequest = 499
+
+// httpError writes the error with the HTTP status code matching its connect code.
+func httpError(w http.ResponseWriter, logger log.Logger, msg string, err error) {
+	code := http.StatusInternalServerError
+	switch connect.CodeOf(err) {
+	case connect.CodeInvalidArgument, connect.CodeFailedPrecondition:
+		code = http.StatusBadRequest
+	case connect.CodeAborted:
+		// An ambiguous expr, answered with the same 409 as the Connect handlers.
+		code = http.StatusConflict
+	case connect.CodeNotFound:
+		code = http.StatusNotFound
+	case connect.CodeDeadlineExceeded:
+		code = http.StatusGatewayTimeout
+	case connect.CodeCanceled:
+		code = statusClientClosedRequest
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		err = errors.New(connectErr.Message())
+	}
+	level.Warn(logger).Log("msg", msg, "err", err)
+	http.Error(w, err.Error(), code)
+}
+
+func writeGrafanaResponse(w http.ResponseWriter, resp any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// burnrateWindows returns all distinct windows of the objective's multi burn rate alerts.
+func burnrateWindows(objective slo.Objective) []time.Duration {
+	seen := map[time.Duration]bool{}
+	var windows []time.Duration
+	for _, w := range objective.Windows() {
+		for _, d := range []time.Duration{w.Short, w.Long} {
+			if !seen[d] {
+				seen[d] = true
+				windows = append(windows, d)
+			}
+		}
+	}
+	sort.Slice(windows, func(i, j int) bool { return windows[i] < windows[j] })
+	return windows
+}
+
+// grafanaMatrix converts a matrix into Grafana timeseries named after the objective.
+// The objective's grouping labels are appended to the name.
+func grafanaMatrix(objective slo.Objective, name string, value model.Value) []grafanaTimeseries {
+	matrix, ok := value.(model.Matrix)
+	if !ok {
+		return nil
+	}
+
+	timeseries := make([]grafanaTimeseries, 0, len(matrix))
+	for _, stream := range matrix {
+		metric := model.Metric{}
+		for _, g := range objective.Grouping() {
+			if v, ok := stream.Metric[model.LabelName(g)]; ok {
+				metric[model.LabelName(g)] = v
+			}
+		}
+
+		target := objective.Name() + " " + name
+		if len(metric) > 0 {
+			target += " " + metric.String()
+		}
+
+		datapoints := make([][2]float64, 0, len(stream.Values))
+		for _, pair := range stream.Values {
+			if math.IsNaN(float64(pair.Value)) {
+				continue // NaN can't be encoded as JSON, Grafana shows a gap instead.
+			}
+			datapoints = append(datapoints, [2]float64{float64(pair.Value), float64(pair.Timestamp)})
+		}
+		timeseries = append(timeseries, grafanaTimeseries{Target: target, Datapoints: datapoints})
+	}
+	return timeseries
+}
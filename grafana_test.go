@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pyrra-dev/pyrra/slo"
+)
+
+func TestGrafanaServer(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{
+			Metric: model.Metric{"job": "api"},
+			Values: []model.SamplePair{
+				{Timestamp: model.TimeFromUnixNano(ts.Add(-time.Minute).UnixNano()), Value: model.SampleValue(math.NaN())},
+				{Timestamp: model.TimeFromUnixNano(ts.UnixNano()), Value: 0.5},
+			},
+		}}
+	}}
+	server := httptest.NewServer((&grafanaServer{
+		logger: log.NewNopLogger(),
+		objectives: &objectiveServer{
+			logger:  log.NewNopLogger(),
+			promAPI: &promCache{api: api},
+			client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+		},
+	}).routes())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Post(server.URL+"/search", "application/json", strings.NewReader(`{"target":""}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var targets []string
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&targets))
+	require.Equal(t, []string{objective.Labels.String()}, targets)
+
+	body, err := json.Marshal(map[string]any{
+		"range":   map[string]string{"from": "2023-01-01T00:00:00Z", "to": "2023-01-01T01:00:00Z"},
+		"targets": []map[string]string{{"target": targets[0]}},
+	})
+	require.NoError(t, err)
+	resp, err = http.Post(server.URL+"/query", "application/json", strings.NewReader(string(body)))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var timeseries []grafanaTimeseries
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&timeseries))
+	require.Len(t, timeseries, 1+len(burnrateWindows(objective)))
+	require.Equal(t, objective.Name()+" error budget", timeseries[0].Target)
+	require.Equal(t, [][2]float64{{0.5, 1672534800000}}, timeseries[0].Datapoints)
+
+	resp, err = http.Post(server.URL+"/query", "application/json", strings.NewReader(`{"targets":[{"target":"foo"}]}`))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -36,9 +36,11 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -61,26 +63,41 @@ func init() {
 
 func cmdKubernetes(
 	logger log.Logger,
+	loggerConfig LoggerConfig,
 	metricsAddr string,
 	configMapMode, genericRules, disableWebhooks bool,
 	certFile, privateKeyFile string,
 	mimirClient *mimir.Client,
 	mimirWriteAlertingRules bool,
+	namespace string,
+	enableLeaderElection bool,
+	leaderElectionNamespace string,
 ) int {
 	setupLog := ctrl.Log.WithName("setup")
-	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
+	ctrl.SetLogger(zap.New(controllerLoggerOptions(loggerConfig)...))
 
 	webhookServer := webhook.NewServer(webhook.Options{Port: 9443})
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	options := ctrl.Options{
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
 			BindAddress: metricsAddr,
 		},
-		WebhookServer:    webhookServer,
-		LeaderElection:   false,
-		LeaderElectionID: "9d76195a.pyrra.dev",
-	})
+		WebhookServer:           webhookServer,
+		LeaderElection:          enableLeaderElection,
+		LeaderElectionID:        "9d76195a.pyrra.dev",
+		LeaderElectionNamespace: leaderElectionNamespace,
+	}
+	if namespace != "" {
+		// Only watch and cache objects in the given namespace,
+		// allowing the operator to run with namespaced RBAC.
+		setupLog.Info("restricting reconciliation to namespace", "namespace", namespace)
+		options.Cache = cache.Options{
+			DefaultNamespaces: map[string]cache.Config{namespace: {}},
+		}
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
@@ -94,6 +111,7 @@ func cmdKubernetes(
 		MimirClient:             mimirClient,
 		MimirWriteAlertingRules: mimirWriteAlertingRules,
 	}
+	reconciler.RegisterMetrics(metrics.Registry)
 	if err = reconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ServiceLevelObjective")
 		os.Exit(1)
@@ -129,7 +147,8 @@ func cmdKubernetes(
 	{
 		router := http.NewServeMux()
 		router.Handle(objectivesv1alpha1connect.NewObjectiveBackendServiceHandler(&KubernetesObjectiveServer{
-			client: mgr.GetClient(),
+			client:    mgr.GetClient(),
+			namespace: namespace,
 		}))
 
 		server := http.Server{
@@ -167,6 +186,8 @@ type KubernetesClient interface {
 
 type KubernetesObjectiveServer struct {
 	client KubernetesClient
+	// namespace the operator is restricted to, empty for all namespaces.
+	namespace string
 }
 
 func (s *KubernetesObjectiveServer) List(ctx context.Context, req *connect.Request[objectivesv1alpha1.ListRequest]) (*connect.Response[objectivesv1alpha1.ListResponse], error) {
@@ -199,6 +220,15 @@ func (s *KubernetesObjectiveServer) List(ctx context.Context, req *connect.Reque
 			break
 		}
 	}
+	if s.namespace != "" {
+		if listOpts.Namespace != "" && listOpts.Namespace != s.namespace {
+			// Objectives of other namespaces aren't cached and can't exist for this operator.
+			return connect.NewResponse(&objectivesv1alpha1.ListResponse{
+				Objectives: []*objectivesv1alpha1.Objective{},
+			}), nil
+		}
+		listOpts.Namespace = s.namespace
+	}
 
 	var list pyrrav1alpha1.ServiceLevelObjectiveList
 	if err := s.client.List(ctx, &list, &listOpts); err != nil {
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -152,6 +153,10 @@ type RatioIndicator struct {
 	// +optional
 	// Grouping allows an SLO to be defined for many SLI at once, like HTTP handlers for example.
 	Grouping []string `json:"grouping"`
+	// +optional
+	// +kubebuilder:validation:Enum=rate;increase;avg_over_time;sum_over_time
+	// Function is applied to the metrics over time. Defaults to rate and increase for counters.
+	Function string `json:"function,omitempty"`
 }
 
 type LatencyIndicator struct {
@@ -162,6 +167,10 @@ type LatencyIndicator struct {
 	// +optional
 	// Grouping allows an SLO to be defined for many SLI at once, like HTTP handlers for example.
 	Grouping []string `json:"grouping"`
+	// +optional
+	// +kubebuilder:validation:Enum=rate;increase;avg_over_time;sum_over_time
+	// Function is applied to the metrics over time. Defaults to rate and increase for counters.
+	Function string `json:"function,omitempty"`
 }
 
 type NativeLatencyIndicator struct {
@@ -267,6 +276,9 @@ func (in *ServiceLevelObjective) validate() (admission.Warnings, error) {
 		if err != nil {
 			return warnings, fmt.Errorf("failed to parse ratio error metric: %w", err)
 		}
+		if err := validateFunction(ratio.Function); err != nil {
+			return warnings, fmt.Errorf("ratio %w", err)
+		}
 	}
 
 	if in.Spec.ServiceLevelIndicator.Latency != nil {
@@ -289,6 +301,9 @@ func (in *ServiceLevelObjective) validate() (admission.Warnings, error) {
 		if err != nil {
 			return warnings, fmt.Errorf("failed to parse latency success metric: %w", err)
 		}
+		if err := validateFunction(latency.Function); err != nil {
+			return warnings, fmt.Errorf("latency %w", err)
+		}
 
 		switch parsedTotal.Type() {
 		case parser.ValueTypeVector:
@@ -359,6 +374,13 @@ func (in *ServiceLevelObjective) validate() (admission.Warnings, error) {
 	return warnings, nil
 }
 
+func validateFunction(function string) error {
+	if function == "" || slices.Contains(slo.Functions, function) {
+		return nil
+	}
+	return fmt.Errorf("function %q is not supported, must be one of: %s", function, strings.Join(slo.Functions, ", "))
+}
+
 func (in *ServiceLevelObjective) Internal() (slo.Objective, error) {
 	target, err := strconv.ParseFloat(in.Spec.Target, 64)
 	if err != nil {
@@ -432,6 +454,7 @@ func (in *ServiceLevelObjective) Internal() (slo.Objective, error) {
 				LabelMatchers: totalVec.LabelMatchers,
 			},
 			Grouping: in.Spec.ServiceLevelIndicator.Ratio.Grouping,
+			Function: in.Spec.ServiceLevelIndicator.Ratio.Function,
 		}
 	}
 
@@ -479,6 +502,7 @@ func (in *ServiceLevelObjective) Internal() (slo.Objective, error) {
 				LabelMatchers: totalMatchers,
 			},
 			Grouping: in.Spec.ServiceLevelIndicator.Latency.Grouping,
+			Function: in.Spec.ServiceLevelIndicator.Latency.Function,
 		}
 	}
 
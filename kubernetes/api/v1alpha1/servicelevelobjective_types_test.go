@@ -484,6 +484,19 @@ func TestServiceLevelObjective_Validate(t *testing.T) {
 			require.EqualError(t, err, "failed to parse ratio total metric: 1:9: parse error: unterminated quoted string")
 			require.Nil(t, warn)
 		})
+
+		t.Run("function", func(t *testing.T) {
+			ratio := ratio()
+			ratio.Spec.ServiceLevelIndicator.Ratio.Function = "avg_over_time"
+			warn, err := ratio.ValidateCreate()
+			require.NoError(t, err)
+			require.Nil(t, warn)
+
+			ratio.Spec.ServiceLevelIndicator.Ratio.Function = "irate"
+			warn, err = ratio.ValidateCreate()
+			require.EqualError(t, err, `ratio function "irate" is not supported, must be one of: rate, increase, avg_over_time, sum_over_time`)
+			require.Nil(t, warn)
+		})
 	})
 
 	t.Run("latency", func(t *testing.T) {
@@ -19,12 +19,14 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	kitlog "github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/rulefmt"
 	yamlv3 "gopkg.in/yaml.v3"
@@ -32,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -51,8 +54,37 @@ type ServiceLevelObjectiveReconciler struct {
 	Scheme                  *runtime.Scheme
 	ConfigMapMode           bool
 	GenericRules            bool
+
+	mu        sync.Mutex
+	generated map[types.NamespacedName]struct{}
+
+	generationErrors *prometheus.CounterVec
+	objectivesTotal  prometheus.Gauge
+}
+
+// RegisterMetrics creates the reconciler's metrics and registers them with the registerer.
+func (r *ServiceLevelObjectiveReconciler) RegisterMetrics(reg prometheus.Registerer) {
+	r.generationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pyrra_objectives_generation_errors_total",
+		Help: "The total amount of errors generating the rules of an objective.",
+	}, []string{"name", "namespace"})
+	r.objectivesTotal = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "pyrra_objectives_total",
+		Help: "The amount of objectives with successfully generated rules.",
+	})
+	reg.MustRegister(r.generationErrors, r.objectivesTotal)
+}
+
+// generationError is returned if the rules of an objective can't be generated,
+// as opposed to errors talking to the Kubernetes API or Mimir that are retried.
+type generationError struct {
+	err error
 }
 
+func (e generationError) Error() string { return e.err.Error() }
+
+func (e generationError) Unwrap() error { return e.err }
+
 // +kubebuilder:rbac:groups=pyrra.dev,resources=servicelevelobjectives,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=pyrra.dev,resources=servicelevelobjectives/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=pyrra.dev,resources=servicelevelobjectives/finalizers,verbs=update
@@ -65,9 +97,40 @@ func (r *ServiceLevelObjectiveReconciler) Reconcile(ctx context.Context, req ctr
 
 	var slo pyrrav1alpha1.ServiceLevelObjective
 	if err := r.Get(ctx, req.NamespacedName, &slo); err != nil {
+		if errors.IsNotFound(err) {
+			r.setGenerated(req.NamespacedName, false)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(fmt.Errorf("getting SLO: %w", err))
 	}
 
+	result, err := r.reconcile(ctx, logger, req, slo)
+	if _, ok := err.(generationError); ok && r.generationErrors != nil {
+		r.generationErrors.WithLabelValues(req.Name, req.Namespace).Inc()
+	}
+	r.setGenerated(req.NamespacedName, err == nil && slo.ObjectMeta.DeletionTimestamp.IsZero())
+
+	return result, err
+}
+
+// setGenerated tracks whether the rules of the objective were generated and updates the objectives gauge.
+func (r *ServiceLevelObjectiveReconciler) setGenerated(name types.NamespacedName, generated bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.generated == nil {
+		r.generated = map[types.NamespacedName]struct{}{}
+	}
+	if generated {
+		r.generated[name] = struct{}{}
+	} else {
+		delete(r.generated, name)
+	}
+	if r.objectivesTotal != nil {
+		r.objectivesTotal.Set(float64(len(r.generated)))
+	}
+}
+
+func (r *ServiceLevelObjectiveReconciler) reconcile(ctx context.Context, logger kitlog.Logger, req ctrl.Request, slo pyrrav1alpha1.ServiceLevelObjective) (ctrl.Result, error) {
 	if r.ConfigMapMode {
 		return r.reconcileConfigMap(ctx, logger, req, slo)
 	}
@@ -109,7 +172,7 @@ func (r *ServiceLevelObjectiveReconciler) Reconcile(ctx context.Context, req ctr
 func (r *ServiceLevelObjectiveReconciler) reconcilePrometheusRule(ctx context.Context, logger kitlog.Logger, req ctrl.Request, kubeObjective pyrrav1alpha1.ServiceLevelObjective) (ctrl.Result, error) {
 	newRule, err := makePrometheusRule(kubeObjective, r.GenericRules)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, generationError{err}
 	}
 
 	var rule monitoringv1.PrometheusRule
@@ -142,7 +205,7 @@ func (r *ServiceLevelObjectiveReconciler) reconcilePrometheusRule(ctx context.Co
 func (r *ServiceLevelObjectiveReconciler) reconcileMimirRuleGroup(ctx context.Context, logger kitlog.Logger, kubeObjective pyrrav1alpha1.ServiceLevelObjective) (ctrl.Result, error) {
 	newRuleGroup, err := makeMimirRuleGroup(kubeObjective, r.GenericRules, r.MimirWriteAlertingRules)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, generationError{err}
 	}
 
 	level.Info(logger).Log("msg", "updating mimir rule", "name", newRuleGroup.Name)
@@ -174,7 +237,7 @@ func (r *ServiceLevelObjectiveReconciler) reconcileConfigMap(
 
 	newConfigMap, err := makeConfigMap(name, kubeObjective, r.GenericRules)
 	if err != nil {
-		return ctrl.Result{}, err
+		return ctrl.Result{}, generationError{err}
 	}
 
 	var existingConfigMap corev1.ConfigMap
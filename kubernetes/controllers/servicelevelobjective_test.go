@@ -1,15 +1,25 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/go-kit/log"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	pyrrav1alpha1 "github.com/pyrra-dev/pyrra/kubernetes/api/v1alpha1"
 	"github.com/pyrra-dev/pyrra/slo"
@@ -388,3 +398,62 @@ func monitoringDuration(d string) *monitoringv1.Duration {
 	md := monitoringv1.Duration(d)
 	return &md
 }
+
+func TestServiceLevelObjectiveReconciler_setGenerated(t *testing.T) {
+	r := &ServiceLevelObjectiveReconciler{}
+	r.RegisterMetrics(prometheus.NewRegistry())
+	foo := types.NamespacedName{Namespace: "monitoring", Name: "foo"}
+	bar := types.NamespacedName{Namespace: "monitoring", Name: "bar"}
+
+	r.setGenerated(foo, true)
+	r.setGenerated(bar, true)
+	r.setGenerated(foo, true)
+	require.Equal(t, 2.0, testutil.ToFloat64(r.objectivesTotal))
+
+	r.setGenerated(foo, false)
+	require.Equal(t, 1.0, testutil.ToFloat64(r.objectivesTotal))
+
+	r.setGenerated(foo, false)
+	r.setGenerated(bar, false)
+	require.Equal(t, 0.0, testutil.ToFloat64(r.objectivesTotal))
+}
+
+func TestServiceLevelObjectiveReconciler_generationErrors(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, pyrrav1alpha1.AddToScheme(scheme))
+	require.NoError(t, monitoringv1.AddToScheme(scheme))
+
+	invalid := httpSLO.DeepCopy()
+	invalid.Namespace = "monitoring"
+	invalid.Name = "invalid"
+	invalid.Spec.Target = "foo"
+
+	valid := httpSLO.DeepCopy()
+	valid.Namespace = "monitoring"
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(invalid, valid).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := obj.(*monitoringv1.PrometheusRule); ok {
+					return fmt.Errorf("connection refused")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	r := &ServiceLevelObjectiveReconciler{Client: c, Logger: log.NewNopLogger()}
+	r.RegisterMetrics(prometheus.NewRegistry())
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(invalid)})
+	require.Error(t, err)
+	require.Equal(t, 1.0, testutil.ToFloat64(r.generationErrors.WithLabelValues("invalid", "monitoring")))
+
+	// Errors talking to the Kubernetes API aren't generation errors.
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(valid)})
+	require.Error(t, err)
+	require.Equal(t, 0.0, testutil.ToFloat64(r.generationErrors.WithLabelValues("http", "monitoring")))
+	require.Equal(t, 0.0, testutil.ToFloat64(r.objectivesTotal))
+}
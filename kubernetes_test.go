@@ -73,12 +73,18 @@ var (
 
 type mockClient struct{}
 
-func (m *mockClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+func (m *mockClient) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
 	switch l := list.(type) {
 	case *pyrrav1alpha1.ServiceLevelObjectiveList:
-		l.Items = append(l.Items, o1)
-		l.Items = append(l.Items, o2)
-		l.Items = append(l.Items, o3)
+		for _, o := range []pyrrav1alpha1.ServiceLevelObjective{o1, o2, o3} {
+			if listOpts.Namespace != "" && listOpts.Namespace != o.GetNamespace() {
+				continue
+			}
+			l.Items = append(l.Items, o)
+		}
 	}
 	return nil
 }
@@ -126,3 +132,38 @@ func TestObjectiveServer_ListObjectives(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectiveServer_ListObjectivesNamespace(t *testing.T) {
+	s := KubernetesObjectiveServer{client: &mockClient{}, namespace: "default"}
+
+	testcases := []struct {
+		name     string
+		expr     string
+		response []*objectivesv1alpha1.Objective
+	}{{
+		name:     "all",
+		expr:     "",
+		response: []*objectivesv1alpha1.Objective{i1, i3},
+	}, {
+		name:     "namespace",
+		expr:     `{namespace="default"}`,
+		response: []*objectivesv1alpha1.Objective{i1, i3},
+	}, {
+		name:     "otherNamespace",
+		expr:     `{namespace="monitoring"}`,
+		response: []*objectivesv1alpha1.Objective{},
+	}, {
+		name:     "namespaceRegex",
+		expr:     `{namespace=~"mon.*"}`,
+		response: []*objectivesv1alpha1.Objective{},
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := s.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{
+				Expr: tc.expr,
+			}))
+			require.NoError(t, err)
+			require.Equal(t, tc.response, response.Msg.Objectives)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-kit/log"
+	"github.com/go-kit/log/level"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+
+	"github.com/pyrra-dev/pyrra/slo"
+)
+
+// cmdLint generates the rules of all config files and prints the problems found with them.
+// It returns 1 if any config file has problems.
+func cmdLint(logger log.Logger, out io.Writer, configFiles string, genericRules bool) int {
+	filenames, err := globConfigFiles(configFiles)
+	if err != nil {
+		level.Error(logger).Log("msg", "getting file names", "err", err)
+		return 1
+	}
+
+	var problems int
+	for _, file := range filenames {
+		var fileProblems []string
+		_, objective, rules, err := objectiveRules(logger, file, genericRules)
+		if err != nil {
+			fileProblems = []string{err.Error()}
+		} else {
+			fileProblems = lintObjective(objective, rules)
+		}
+
+		for _, p := range fileProblems {
+			if _, err := fmt.Fprintf(out, "%s: %s\n", file, p); err != nil {
+				level.Error(logger).Log("msg", "failed to print problems", "err", err)
+				return 1
+			}
+		}
+		problems += len(fileProblems)
+	}
+
+	if problems > 0 {
+		level.Error(logger).Log("msg", "config files have problems", "files", len(filenames), "problems", problems)
+		return 1
+	}
+	level.Info(logger).Log("msg", "config files have no problems", "files", len(filenames))
+	return 0
+}
+
+// lintObjective returns the problems with the objective's multi burn rate windows and its generated rules.
+// The rules' expressions must be valid PromQL and their for durations must parse.
+func lintObjective(objective slo.Objective, rules monitoringv1.PrometheusRuleSpec) []string {
+	var problems []string
+
+	for i, w := range objective.Windows() {
+		if err := w.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("window %d: %s", i, err))
+		}
+		if w.Long > time.Duration(objective.Window) {
+			problems = append(problems, fmt.Sprintf("window %d: long window %s must not be longer than the objective's window %s", i, w.Long, objective.Window))
+		}
+		if w.For > w.Long {
+			problems = append(problems, fmt.Sprintf("window %d: for %s must not be longer than long window %s", i, w.For, w.Long))
+		}
+		if w.Factor < 1 {
+			problems = append(problems, fmt.Sprintf("window %d: factor %g must be at least 1", i, w.Factor))
+		}
+	}
+
+	for _, group := range rules.Groups {
+		for _, rule := range group.Rules {
+			name := rule.Record
+			if name == "" {
+				name = rule.Alert
+			}
+			if _, err := parser.ParseExpr(rule.Expr.String()); err != nil {
+				problems = append(problems, fmt.Sprintf("group %s: rule %s: invalid expr: %v", group.Name, name, err))
+			}
+			if rule.For != nil {
+				if _, err := model.ParseDuration(string(*rule.For)); err != nil {
+					problems = append(problems, fmt.Sprintf("group %s: rule %s: invalid for: %v", group.Name, name, err))
+				}
+			}
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/log"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/pyrra-dev/pyrra/slo"
+)
+
+func TestLint(t *testing.T) {
+	dir := t.TempDir()
+	config, err := os.ReadFile("examples/pyrra-filesystem-errors.yaml")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "valid.yaml"), config, 0o644))
+
+	var out strings.Builder
+	require.Equal(t, 0, cmdLint(log.NewNopLogger(), &out, filepath.Join(dir, "*.yaml"), true))
+	require.Empty(t, out.String())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.yaml"), []byte("spec: {}\n"), 0o644))
+	out.Reset()
+	require.Equal(t, 1, cmdLint(log.NewNopLogger(), &out, filepath.Join(dir, "*.yaml"), true))
+	require.True(t, strings.HasPrefix(out.String(), filepath.Join(dir, "invalid.yaml")+": "))
+	require.NotContains(t, out.String(), filepath.Join(dir, "valid.yaml")+": ")
+}
+
+func TestLintObjective(t *testing.T) {
+	valid := slo.Objective{Window: model.Duration(28 * 24 * time.Hour)}
+	require.Empty(t, lintObjective(valid, monitoringv1.PrometheusRuleSpec{}))
+
+	// The short windows of a single day round down to 0.
+	short := slo.Objective{Window: model.Duration(24 * time.Hour)}
+	require.Contains(t, lintObjective(short, monitoringv1.PrometheusRuleSpec{}), "window 0: short window 0s must be positive")
+
+	invalidFor := monitoringv1.Duration("2x")
+	rules := monitoringv1.PrometheusRuleSpec{Groups: []monitoringv1.RuleGroup{{
+		Name: "errors",
+		Rules: []monitoringv1.Rule{{
+			Record: "http_requests:burnrate5m",
+			Expr:   intstr.FromString(`sum(rate(http_requests_total{code=~"5.."}[5m]))`),
+		}, {
+			Alert: "ErrorBudgetBurn",
+			Expr:  intstr.FromString(`sum(rate(http_requests_total[5m])`),
+			For:   &invalidFor,
+		}},
+	}}}
+	problems := lintObjective(valid, rules)
+	require.Len(t, problems, 2)
+	require.True(t, strings.HasPrefix(problems[0], "group errors: rule ErrorBudgetBurn: invalid expr: "))
+	require.True(t, strings.HasPrefix(problems[1], "group errors: rule ErrorBudgetBurn: invalid for: "))
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
+	"go.uber.org/zap/zapcore"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
 var errInvalidLogFormatFlag = errors.New("--log-format must be either 'json' or 'logfmt'")
@@ -43,3 +45,28 @@ func configureLogger(loggerConfig LoggerConfig) log.Logger {
 	logger = log.WithPrefix(logger, "ts", log.DefaultTimestampUTC)
 	return logger
 }
+
+// controllerLoggerOptions returns the options for controller-runtime's zap logger,
+// so that the Kubernetes operator respects the same level and format as the go-kit logger.
+// zap has no logfmt encoder, the console encoder is used instead.
+func controllerLoggerOptions(loggerConfig LoggerConfig) []zap.Opts {
+	var lvl zapcore.Level
+	switch level.ParseDefault(loggerConfig.LogLevel, level.InfoValue()) {
+	case level.DebugValue():
+		lvl = zapcore.DebugLevel
+	case level.WarnValue():
+		lvl = zapcore.WarnLevel
+	case level.ErrorValue():
+		lvl = zapcore.ErrorLevel
+	default:
+		lvl = zapcore.InfoLevel
+	}
+
+	opts := []zap.Opts{zap.Level(lvl), zap.StacktraceLevel(zapcore.PanicLevel)}
+	if loggerConfig.LogFormat == "json" {
+		opts = append(opts, zap.JSONEncoder())
+	} else {
+		opts = append(opts, zap.ConsoleEncoder())
+	}
+	return opts
+}
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/go-kit/log/level"
 	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
 
 func TestLoggerConfig_Validate(t *testing.T) {
@@ -61,3 +63,14 @@ func TestLoggerConfig_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestControllerLoggerOptions(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zap.New(append(controllerLoggerOptions(LoggerConfig{LogLevel: "warn", LogFormat: "json"}), zap.WriteTo(&buf))...)
+
+	logger.Info("hidden")
+	require.Empty(t, buf.String())
+
+	logger.Error(nil, "visible")
+	require.Contains(t, buf.String(), `"msg":"visible"`)
+}
@@ -1,17 +1,28 @@
 package main
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
 	"io"
 	"io/fs"
 	"math"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"path"
+	"regexp"
+	"runtime"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,11 +34,13 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/dgraph-io/ristretto"
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	"github.com/go-kit/log"
 	"github.com/go-kit/log/level"
 	"github.com/oklog/run"
 	connectprometheus "github.com/polarsignals/connect-go-prometheus"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus/client_golang/api"
 	prometheusapiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/client_golang/prometheus"
@@ -37,10 +50,19 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/labels"
 	"github.com/prometheus/prometheus/promql/parser"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/yaml"
 
+	"github.com/pyrra-dev/pyrra/kubernetes/api/v1alpha1"
 	"github.com/pyrra-dev/pyrra/mimir"
 	objectivesv1alpha1 "github.com/pyrra-dev/pyrra/proto/objectives/v1alpha1"
 	"github.com/pyrra-dev/pyrra/proto/objectives/v1alpha1/objectivesv1alpha1connect"
@@ -51,26 +73,77 @@ import (
 //go:embed ui/build
 var ui embed.FS
 
+// version and commit are set at build time via -ldflags "-X main.version=... -X main.commit=...".
+var (
+	version = "unknown"
+	commit  = "unknown"
+)
+
 var CLI struct {
 	LoggerConfig
 	API struct {
-		PrometheusURL               *url.URL          `default:"http://localhost:9090" help:"The URL to the Prometheus to query."`
-		PrometheusExternalURL       *url.URL          `help:"The URL for the UI to redirect users to when opening Prometheus. If empty the same as prometheus.url"`
-		APIURL                      *url.URL          `name:"api-url" default:"http://localhost:9444" help:"The URL to the API service like a Kubernetes Operator."`
-		RoutePrefix                 string            `default:"" help:"The route prefix Pyrra uses. If run behind a proxy you can change it to something like /pyrra here."`
-		UIRoutePrefix               string            `default:"" help:"The route prefix Pyrra's UI uses. This is helpful for when the prefix is stripped by a proxy but still runs on /pyrra. Defaults to --route-prefix"`
-		PrometheusBearerTokenPath   string            `default:"" help:"Bearer token path"`
-		PrometheusBasicAuthUsername string            `default:"" help:"The HTTP basic authentication username"`
-		PrometheusBasicAuthPassword promconfig.Secret `default:"" help:"The HTTP basic authentication password"`
-		TLSCertFile                 string            `default:"" help:"File containing the default x509 Certificate for HTTPS."`
-		TLSPrivateKeyFile           string            `default:"" help:"File containing the default x509 private key matching --tls-cert-file."`
-		TLSClientCAFile             string            `default:"" help:"File containing the CA certificate for the client"`
+		PrometheusURL                    *url.URL          `default:"http://localhost:9090" help:"The URL to the Prometheus to query."`
+		PrometheusURLSecondary           *url.URL          `name:"prometheus-url-secondary" help:"The URL to a secondary Prometheus, e.g. of another region or a blue/green cluster, to compare range queries against. Uses the same client configuration as --prometheus-url. Comparisons are disabled if empty."`
+		PrometheusExternalURL            *url.URL          `help:"The URL for the UI to redirect users to when opening Prometheus. If empty the same as prometheus.url"`
+		PrometheusType                   string            `default:"thanos" enum:"prometheus,thanos" help:"The type of Prometheus API to query. Valid options are 'prometheus' or 'thanos'. Thanos disables partial responses and queries downsampled data for long ranges."`
+		PrometheusQueryTimeout           time.Duration     `default:"30s" help:"The timeout for each query against Prometheus. Set to 0 to disable."`
+		PrometheusMaxConcurrentQueries   int               `default:"20" help:"The maximum number of concurrent queries against Prometheus. Further queries wait for a free slot. Set to 0 to disable."`
+		PrometheusQueryRetries           int               `default:"2" help:"How often queries failing with 5xx responses or network errors are retried. Set to 0 to disable."`
+		PrometheusQueryRetryBackoff      time.Duration     `default:"100ms" help:"The time to wait before retrying a failed query. Doubles with each retry."`
+		APIURL                           []*url.URL        `name:"api-url" default:"http://localhost:9444" help:"The URL to the API service like a Kubernetes Operator. Repeat the flag or separate URLs by comma to aggregate the objectives of multiple API services."`
+		ListenAddress                    string            `default:":9099" help:"The address the HTTP server binds to, e.g. 127.0.0.1:9099."`
+		GracefulShutdownTimeout          time.Duration     `default:"10s" help:"The time to wait for in-flight requests to finish when shutting down."`
+		RoutePrefix                      string            `default:"" help:"The route prefix Pyrra uses. If run behind a proxy you can change it to something like /pyrra here."`
+		UIRoutePrefix                    string            `default:"" help:"The route prefix Pyrra's UI uses. This is helpful for when the prefix is stripped by a proxy but still runs on /pyrra. Defaults to --route-prefix"`
+		UITitle                          string            `name:"ui-title" default:"Pyrra" help:"The title of the UI's pages, e.g. to white-label Pyrra."`
+		UILogoURL                        string            `name:"ui-logo-url" default:"" help:"The URL of the logo shown in the UI's navigation bar. Defaults to Pyrra's logo."`
+		MaxQueryResolution               int               `default:"1000" help:"The maximum number of points returned per series for range queries."`
+		MinQueryStep                     time.Duration     `default:"15s" help:"The minimum step between points of range queries. Should not be lower than the scrape interval."`
+		MaxQueryRange                    time.Duration     `default:"8760h" help:"The maximum time range of graphs requested with a start and end, protecting Prometheus from huge range queries. Set to 0 to disable."`
+		DefaultRateWindow                time.Duration     `default:"5m" help:"The rate window used for graphs of short time ranges. Longer time ranges use proportionally larger windows. Should be at least 4 times the scrape interval."`
+		DefaultErrorBudgetRange          time.Duration     `name:"default-errorbudget-range" default:"0" help:"The maximum time range of error budget graphs requested without a start and end. By default they show the objective's entire window."`
+		QueryOffset                      time.Duration     `default:"0" help:"Evaluates requests without an explicit time or range, e.g. the statuses of the list page, this long before now, for delayed metrics, e.g. ingested via remote-write, to have settled. Explicit times and ranges, as requested by the detail page, aren't shifted."`
+		ExposeQueries                    bool              `default:"false" help:"Include the PromQL query in errors of failed Prometheus queries returned to clients, to debug them in Prometheus. Exposes internal metric names, only enable it for development."`
+		CORSAllowedOrigins               []string          `help:"Comma-separated list of origins allowed to make cross-origin requests, e.g. http://localhost:3000 for UI development. CORS is disabled if empty."`
+		AccessLogSkipPaths               []string          `default:"/metrics,/healthz,/readyz" help:"Comma-separated list of request paths, with or without the route prefix, to exclude from the access log."`
+		APIRateLimit                     float64           `name:"api-rate-limit" default:"0" help:"The number of API requests per second each client IP may send, protecting Prometheus from e.g. dashboards refreshing many objectives at once. Health checks, metrics and UI files aren't limited. Set to 0 to disable."`
+		APIRateLimitBurst                int               `name:"api-rate-limit-burst" default:"50" help:"The number of API requests a client IP may send at once before --api-rate-limit applies."`
+		PrometheusBearerTokenPath        string            `default:"" help:"File containing the bearer token to authenticate against Prometheus. Recommended over --prometheus-bearer-token."`
+		PrometheusBearerToken            string            `default:"" env:"PROMETHEUS_BEARER_TOKEN" help:"Bearer token to authenticate against Prometheus. Prefer setting it via the PROMETHEUS_BEARER_TOKEN environment variable to not expose it in the process list."`
+		PrometheusBasicAuthUsername      string            `default:"" help:"The HTTP basic authentication username"`
+		PrometheusBasicAuthPassword      promconfig.Secret `default:"" help:"The HTTP basic authentication password"`
+		PrometheusBasicAuthPasswordPath  string            `default:"" help:"The path to a file containing the HTTP basic authentication password. Preferred over --prometheus-basic-auth-password to keep the password out of the process arguments."`
+		PrometheusOAuth2ClientID         string            `name:"prometheus-oauth2-client-id" default:"" help:"The OAuth2 client ID to authenticate against Prometheus with the client credentials flow."`
+		PrometheusOAuth2ClientSecretFile string            `name:"prometheus-oauth2-client-secret-file" default:"" help:"The path to a file containing the OAuth2 client secret."`
+		PrometheusOAuth2TokenURL         string            `name:"prometheus-oauth2-token-url" default:"" help:"The URL to fetch OAuth2 tokens from."`
+		PrometheusOAuth2Scopes           []string          `name:"prometheus-oauth2-scopes" help:"Comma-separated list of OAuth2 scopes to request."`
+		PrometheusTenantHeader           string            `default:"X-Scope-OrgID" help:"The header to send the tenant in, see --prometheus-tenant."`
+		PrometheusTenant                 string            `default:"" help:"The tenant to send with every query, e.g. for multi-tenant Cortex, Mimir or Thanos setups."`
+		PrometheusUserAgent              string            `default:"" help:"The User-Agent header sent with every query, to tell Pyrra's queries apart in Prometheus' logs. Defaults to pyrra/<version>."`
+		TLSCertFile                      string            `default:"" help:"File containing the default x509 Certificate for HTTPS."`
+		TLSPrivateKeyFile                string            `default:"" help:"File containing the default x509 private key matching --tls-cert-file."`
+		PrometheusCAFile                 string            `default:"" aliases:"tls-client-ca-file" help:"File containing the CA certificate to verify the Prometheus server certificate. --tls-client-ca-file is a deprecated alias."`
+		PrometheusCertFile               string            `default:"" help:"File containing the client certificate for mTLS connections to Prometheus."`
+		PrometheusKeyFile                string            `default:"" help:"File containing the client private key matching --prometheus-cert-file."`
+		PrometheusInsecureSkipVerify     bool              `default:"false" help:"Disable verification of the Prometheus server certificate."`
+		PrometheusDisableCompression     bool              `default:"false" help:"Disable requesting gzip compressed responses from Prometheus, e.g. if a proxy in between mishandles them."`
+		DisableCache                     bool              `default:"false" help:"Disables the in-memory query cache, all queries are sent to Prometheus directly."`
+		CacheMaxSizeBytes                int64             `default:"1073741824" help:"The maximum size of the query cache in bytes. Setting it to 0 disables the cache entirely."`
+		CacheQueryTTL                    time.Duration     `default:"0" help:"Overrides how long instant query results are cached. By default alerts are cached for 5s, statuses and burn rates depending on their window."`
+		CacheQueryRangeTTL               time.Duration     `default:"0" help:"Overrides how long range query results are cached. By default error budgets are cached for 15s and other graphs depending on the queried time range."`
+		CacheQueryRangeBucket            time.Duration     `default:"1m" help:"Range queries whose start and end fall into the same buckets of this duration share cached results, e.g. of consecutive dashboard refreshes. Set to 0 to use the query's step."`
+		CacheNegativeTTL                 time.Duration     `default:"30s" help:"How long empty query results are cached, e.g. for objectives without any traffic yet. Set to 0 to never cache empty results."`
+		OTLPEndpoint                     string            `default:"" help:"The OTLP HTTP endpoint to export traces to, e.g. localhost:4318. Tracing is disabled if empty."`
+		OTLPInsecure                     bool              `default:"false" help:"Export traces to the OTLP endpoint without TLS."`
+		BackendCacheTTL                  time.Duration     `default:"10s" help:"How long objectives fetched from the backend are cached to resolve a single objective. Listing objectives always fetches them fresh. Set to 0 to disable."`
+		Demo                             bool              `default:"false" help:"Respond with empty graphs instead of errors for objectives without data, e.g. for demos against a Prometheus without Pyrra's recording rules."`
 	} `cmd:"" help:"Runs Pyrra's API and UI."`
 	Filesystem struct {
-		ConfigFiles      string   `default:"/etc/pyrra/*.yaml" help:"The folder where Pyrra finds the config files to use. Any non yaml files will be ignored."`
+		ConfigFiles      string   `default:"/etc/pyrra/*.yaml" help:"The folder where Pyrra finds the config files to use. Any non yaml files will be ignored. Use ** to match subdirectories, e.g. /etc/pyrra/**/*.yaml."`
 		PrometheusURL    *url.URL `default:"http://localhost:9090" help:"The URL to the Prometheus to query."`
 		PrometheusFolder string   `default:"/etc/prometheus/pyrra/" help:"The folder where Pyrra writes the generates Prometheus rules and alerts."`
 		GenericRules     bool     `default:"false" help:"Enabled generic recording rules generation to make it easier for tools like Grafana."`
+		DryRun           bool     `default:"false" help:"Print the generated rules of all config files to stdout instead of writing them, then exit. Exits non-zero if any config file fails to generate."`
 	} `cmd:"" help:"Runs Pyrra's filesystem operator and backend for the API."`
 	Kubernetes struct {
 		MetricsAddr             string   `default:":8080" help:"The address the metric endpoint binds to."`
@@ -84,13 +157,21 @@ var CLI struct {
 		MimirBasicAuthUsername  string   `default:"" help:"The HTTP basic authentication username"`
 		MimirBasicAuthPassword  string   `default:"" help:"The HTTP basic authentication password"`
 		MimirWriteAlertingRules bool     `default:"false" help:"If alerting rules should be provisioned to the Mimir Ruler."`
+		Namespace               string   `default:"" env:"WATCH_NAMESPACE" help:"Only reconcile ServiceLevelObjectives in this namespace. Defaults to all namespaces."`
+		EnableLeaderElection    bool     `default:"false" help:"Enable leader election so only one of multiple replicas reconciles at a time."`
+		LeaderElectionNamespace string   `default:"" help:"The namespace to create the leader election lease in. Defaults to the namespace Pyrra is running in."`
 	} `cmd:"" help:"Runs Pyrra's Kubernetes operator and backend for the API."`
 	Generate struct {
-		ConfigFiles      string `default:"/etc/pyrra/*.yaml" help:"The folder where Pyrra finds the config files to use."`
+		ConfigFiles      string `default:"/etc/pyrra/*.yaml" help:"The folder where Pyrra finds the config files to use. Use ** to match subdirectories, e.g. /etc/pyrra/**/*.yaml."`
 		PrometheusFolder string `default:"/etc/prometheus/pyrra/" help:"The folder where Pyrra writes the generated Prometheus rules and alerts."`
 		GenericRules     bool   `default:"false" help:"Enabled generic recording rules generation to make it easier for tools like Grafana."`
 		OperatorRule     bool   `default:"false" help:"Generate rule files as prometheus-operator PrometheusRule: https://prometheus-operator.dev/docs/operator/api/#monitoring.coreos.com/v1.PrometheusRule."`
+		Config           string `default:"" help:"Generate the rules of this single config file and print them to stdout instead of writing rule files."`
 	} `cmd:"" help:"Read SLO config files and rewrites them as Prometheus rules and alerts."`
+	Lint struct {
+		ConfigFiles  string `default:"/etc/pyrra/*.yaml" help:"The folder where Pyrra finds the config files to lint. Use ** to match subdirectories, e.g. /etc/pyrra/**/*.yaml."`
+		GenericRules bool   `default:"false" help:"Lint the generic recording rules too."`
+	} `cmd:"" help:"Generate the rules of SLO config files and check them for problems, e.g. in CI. Exits non-zero if any problems are found."`
 }
 
 func main() {
@@ -116,24 +197,58 @@ func main() {
 	}
 
 	clientConfig := promconfig.HTTPClientConfig{}
-	if CLI.API.PrometheusBasicAuthUsername != "" && CLI.API.PrometheusBasicAuthPassword != "" {
-		clientConfig.BasicAuth = &promconfig.BasicAuth{
-			Username: CLI.API.PrometheusBasicAuthUsername,
-			Password: CLI.API.PrometheusBasicAuthPassword,
-		}
+	if err := prometheusBasicAuth(
+		&clientConfig,
+		CLI.API.PrometheusBasicAuthUsername,
+		CLI.API.PrometheusBasicAuthPassword,
+		CLI.API.PrometheusBasicAuthPasswordPath,
+	); err != nil {
+		level.Error(logger).Log("msg", "invalid Prometheus basic auth configuration", "err", err)
+		os.Exit(1)
+	}
+	if err := prometheusBearerToken(&clientConfig, CLI.API.PrometheusBearerToken, CLI.API.PrometheusBearerTokenPath); err != nil {
+		level.Error(logger).Log("msg", "invalid Prometheus bearer token configuration", "err", err)
+		os.Exit(1)
+	}
+	if err := prometheusOAuth2(
+		&clientConfig,
+		CLI.API.PrometheusOAuth2ClientID,
+		CLI.API.PrometheusOAuth2ClientSecretFile,
+		CLI.API.PrometheusOAuth2TokenURL,
+		CLI.API.PrometheusOAuth2Scopes,
+	); err != nil {
+		level.Error(logger).Log("msg", "invalid Prometheus OAuth2 configuration", "err", err)
+		os.Exit(1)
 	}
-	if CLI.API.PrometheusBearerTokenPath != "" {
-		clientConfig.BearerTokenFile = CLI.API.PrometheusBearerTokenPath
+	if err := prometheusTenant(&clientConfig, CLI.API.PrometheusTenantHeader, CLI.API.PrometheusTenant); err != nil {
+		level.Error(logger).Log("msg", "invalid Prometheus tenant configuration", "err", err)
+		os.Exit(1)
+	}
+	// The round tripper created from the TLS config is wrapped by the authentication round trippers.
+	clientConfig.TLSConfig = promconfig.TLSConfig{
+		CAFile:             CLI.API.PrometheusCAFile,
+		CertFile:           CLI.API.PrometheusCertFile,
+		KeyFile:            CLI.API.PrometheusKeyFile,
+		InsecureSkipVerify: CLI.API.PrometheusInsecureSkipVerify,
 	}
-	if CLI.API.TLSClientCAFile != "" {
-		clientConfig.TLSConfig = promconfig.TLSConfig{CAFile: CLI.API.TLSClientCAFile}
+	// Validate makes sure only one of the authentication methods is configured.
+	if err := clientConfig.Validate(); err != nil {
+		level.Error(logger).Log("msg", "invalid Prometheus client configuration", "err", err)
+		os.Exit(1)
 	}
 
-	roundTripper, err := promconfig.NewRoundTripperFromConfig(clientConfig, "prometheus")
+	roundTripper, err := promconfig.NewRoundTripperFromConfig(
+		clientConfig,
+		"prometheus",
+		promconfig.WithUserAgent(prometheusUserAgent(CLI.API.PrometheusUserAgent)),
+	)
 	if err != nil {
 		level.Error(logger).Log("msg", "failed to create API client round tripper", "err", err)
 		os.Exit(1)
 	}
+	if !CLI.API.PrometheusDisableCompression {
+		roundTripper = &gzipRoundTripper{next: roundTripper}
+	}
 
 	client, err := api.NewClient(api.Config{
 		Address:      prometheusURL.String(),
@@ -143,9 +258,28 @@ func main() {
 		level.Error(logger).Log("msg", "failed to create API client", "err", err)
 		os.Exit(1)
 	}
-	// Wrap client to add extra headers for Thanos.
-	client = newThanosClient(client)
-	level.Info(logger).Log("msg", "using Prometheus", "url", prometheusURL.String())
+	if CLI.API.PrometheusType != "prometheus" {
+		// Wrap client to add extra headers for Thanos.
+		client = newThanosClient(client)
+	}
+	level.Info(logger).Log("msg", "using Prometheus", "url", prometheusURL.String(), "type", CLI.API.PrometheusType)
+
+	// The secondary Prometheus is only queried to compare range queries against the primary one.
+	var secondaryClient api.Client
+	if ctx.Command() == "api" && CLI.API.PrometheusURLSecondary != nil {
+		secondaryClient, err = api.NewClient(api.Config{
+			Address:      CLI.API.PrometheusURLSecondary.String(),
+			RoundTripper: roundTripper,
+		})
+		if err != nil {
+			level.Error(logger).Log("msg", "failed to create secondary API client", "err", err)
+			os.Exit(1)
+		}
+		if CLI.API.PrometheusType != "prometheus" {
+			secondaryClient = newThanosClient(secondaryClient)
+		}
+		level.Info(logger).Log("msg", "using secondary Prometheus for comparisons", "url", CLI.API.PrometheusURLSecondary.String())
+	}
 
 	if CLI.API.PrometheusExternalURL == nil {
 		CLI.API.PrometheusExternalURL = prometheusURL
@@ -183,14 +317,50 @@ func main() {
 			logger,
 			reg,
 			client,
+			secondaryClient,
 			CLI.API.PrometheusExternalURL,
 			CLI.API.APIURL,
+			CLI.API.ListenAddress,
 			CLI.API.RoutePrefix,
 			CLI.API.UIRoutePrefix,
+			CLI.API.UITitle,
+			CLI.API.UILogoURL,
 			CLI.API.TLSCertFile,
 			CLI.API.TLSPrivateKeyFile,
+			promCacheConfig{
+				Disabled:      CLI.API.DisableCache,
+				MaxSizeBytes:  CLI.API.CacheMaxSizeBytes,
+				QueryTTL:      CLI.API.CacheQueryTTL,
+				QueryRangeTTL: CLI.API.CacheQueryRangeTTL,
+				RangeBucket:   CLI.API.CacheQueryRangeBucket,
+				NegativeTTL:   CLI.API.CacheNegativeTTL,
+			},
+			CLI.API.BackendCacheTTL,
+			CLI.API.PrometheusQueryTimeout,
+			CLI.API.PrometheusMaxConcurrentQueries,
+			CLI.API.PrometheusQueryRetries,
+			CLI.API.PrometheusQueryRetryBackoff,
+			CLI.API.MaxQueryResolution,
+			CLI.API.MinQueryStep,
+			CLI.API.MaxQueryRange,
+			CLI.API.DefaultRateWindow,
+			CLI.API.DefaultErrorBudgetRange,
+			CLI.API.QueryOffset,
+			CLI.API.ExposeQueries,
+			CLI.API.GracefulShutdownTimeout,
+			CLI.API.CORSAllowedOrigins,
+			CLI.API.AccessLogSkipPaths,
+			CLI.API.APIRateLimit,
+			CLI.API.APIRateLimitBurst,
+			CLI.API.OTLPEndpoint,
+			CLI.API.OTLPInsecure,
+			CLI.API.Demo,
 		)
 	case "filesystem":
+		if CLI.Filesystem.DryRun {
+			code = cmdFilesystemDryRun(logger, os.Stdout, CLI.Filesystem.ConfigFiles, CLI.Filesystem.GenericRules)
+			break
+		}
 		code = cmdFilesystem(
 			logger,
 			reg,
@@ -202,6 +372,7 @@ func main() {
 	case "kubernetes":
 		code = cmdKubernetes(
 			logger,
+			CLI.LoggerConfig,
 			CLI.Kubernetes.MetricsAddr,
 			CLI.Kubernetes.ConfigMapMode,
 			CLI.Kubernetes.GenericRules,
@@ -210,8 +381,15 @@ func main() {
 			CLI.Kubernetes.TLSPrivateKeyFile,
 			mimirClient,
 			CLI.Kubernetes.MimirWriteAlertingRules,
+			CLI.Kubernetes.Namespace,
+			CLI.Kubernetes.EnableLeaderElection,
+			CLI.Kubernetes.LeaderElectionNamespace,
 		)
 	case "generate":
+		if CLI.Generate.Config != "" {
+			code = cmdGenerateConfig(logger, os.Stdout, CLI.Generate.Config, CLI.Generate.GenericRules, CLI.Generate.OperatorRule)
+			break
+		}
 		code = cmdGenerate(
 			logger,
 			CLI.Generate.ConfigFiles,
@@ -219,69 +397,303 @@ func main() {
 			CLI.Generate.GenericRules,
 			CLI.Generate.OperatorRule,
 		)
+	case "lint":
+		code = cmdLint(logger, os.Stdout, CLI.Lint.ConfigFiles, CLI.Lint.GenericRules)
 	}
 	os.Exit(code)
 }
 
+// prometheusBearerToken configures the client to authenticate with either the token or the token read from the file.
+func prometheusBearerToken(clientConfig *promconfig.HTTPClientConfig, token, tokenFile string) error {
+	if token != "" && tokenFile != "" {
+		return errors.New("only one of --prometheus-bearer-token and --prometheus-bearer-token-path can be set")
+	}
+	clientConfig.BearerToken = promconfig.Secret(token)
+	clientConfig.BearerTokenFile = tokenFile
+	return nil
+}
+
+// prometheusOAuth2 configures the client to authenticate with the OAuth2 client credentials flow if a client ID is set.
+// Tokens are fetched from the token URL and refreshed before they expire.
+func prometheusOAuth2(clientConfig *promconfig.HTTPClientConfig, clientID, clientSecretFile, tokenURL string, scopes []string) error {
+	if clientID == "" {
+		return nil
+	}
+	if clientSecretFile == "" || tokenURL == "" {
+		return errors.New("--prometheus-oauth2-client-secret-file and --prometheus-oauth2-token-url are required with --prometheus-oauth2-client-id")
+	}
+	clientConfig.OAuth2 = &promconfig.OAuth2{
+		ClientID:         clientID,
+		ClientSecretFile: clientSecretFile,
+		TokenURL:         tokenURL,
+		Scopes:           scopes,
+	}
+	return nil
+}
+
+// prometheusTenant configures the client to send the tenant in the header with every request if a tenant is set.
+// The header is added in addition to the authentication configured.
+func prometheusTenant(clientConfig *promconfig.HTTPClientConfig, header, tenant string) error {
+	if tenant == "" {
+		return nil
+	}
+	if header == "" {
+		return errors.New("--prometheus-tenant-header can't be empty with --prometheus-tenant")
+	}
+	clientConfig.HTTPHeaders = &promconfig.Headers{Headers: map[string]promconfig.Header{
+		header: {Values: []string{tenant}},
+	}}
+	return nil
+}
+
+// prometheusUserAgent returns the User-Agent to query Prometheus with, pyrra/<version> unless overridden.
+func prometheusUserAgent(userAgent string) string {
+	if userAgent != "" {
+		return userAgent
+	}
+	return "pyrra/" + version
+}
+
+// prometheusBasicAuth configures basic authentication with the password or the file containing it.
+// A password without a username is rejected instead of silently querying Prometheus unauthenticated.
+func prometheusBasicAuth(config *promconfig.HTTPClientConfig, username string, password promconfig.Secret, passwordFile string) error {
+	if password == "" && passwordFile == "" {
+		return nil
+	}
+	if username == "" {
+		return fmt.Errorf("--prometheus-basic-auth-username is required with a basic auth password")
+	}
+	config.BasicAuth = &promconfig.BasicAuth{
+		Username:     username,
+		Password:     password,
+		PasswordFile: passwordFile,
+	}
+	return nil
+}
+
 func cmdAPI(
 	logger log.Logger,
 	reg *prometheus.Registry,
 	promClient api.Client,
-	prometheusExternal, apiURL *url.URL,
+	secondaryClient api.Client,
+	prometheusExternal *url.URL,
+	apiURLs []*url.URL,
+	listenAddress string,
 	routePrefix, uiRoutePrefix string,
+	uiTitle, uiLogoURL string,
 	tlsCertFile, tlsPrivateKeyFile string,
+	cacheConfig promCacheConfig,
+	backendCacheTTL time.Duration,
+	queryTimeout time.Duration,
+	maxConcurrentQueries int,
+	queryRetries int,
+	queryRetryBackoff time.Duration,
+	maxQueryResolution int,
+	minQueryStep time.Duration,
+	maxQueryRange time.Duration,
+	defaultRateWindow time.Duration,
+	defaultErrorBudgetRange time.Duration,
+	queryOffset time.Duration,
+	exposeQueries bool,
+	gracefulShutdownTimeout time.Duration,
+	corsAllowedOrigins []string,
+	accessLogSkipPaths []string,
+	apiRateLimit float64,
+	apiRateLimitBurst int,
+	otlpEndpoint string,
+	otlpInsecure bool,
+	demo bool,
 ) int {
+	if _, _, err := net.SplitHostPort(listenAddress); err != nil {
+		level.Error(logger).Log("msg", "invalid listen address", "address", listenAddress, "err", err)
+		return 1
+	}
+	if defaultRateWindow <= 0 {
+		level.Error(logger).Log("msg", "default rate window must be positive", "window", defaultRateWindow)
+		return 1
+	}
+	if defaultErrorBudgetRange < 0 {
+		level.Error(logger).Log("msg", "default error budget range must not be negative", "range", defaultErrorBudgetRange)
+		return 1
+	}
+	if queryOffset < 0 {
+		level.Error(logger).Log("msg", "query offset must not be negative", "offset", queryOffset)
+		return 1
+	}
+	if maxQueryResolution <= 0 {
+		level.Error(logger).Log("msg", "max query resolution must be positive", "resolution", maxQueryResolution)
+		return 1
+	}
+	if maxQueryRange < 0 {
+		level.Error(logger).Log("msg", "max query range must not be negative", "range", maxQueryRange)
+		return 1
+	}
+	if len(apiURLs) == 0 {
+		level.Error(logger).Log("msg", "at least one API URL is required")
+		return 1
+	}
+	if apiRateLimit < 0 {
+		level.Error(logger).Log("msg", "API rate limit must not be negative", "limit", apiRateLimit)
+		return 1
+	}
+	if apiRateLimit > 0 && apiRateLimitBurst <= 0 {
+		level.Error(logger).Log("msg", "API rate limit burst must be positive", "burst", apiRateLimitBurst)
+		return 1
+	}
+	if (tlsCertFile == "") != (tlsPrivateKeyFile == "") {
+		level.Error(logger).Log("msg", "both --tls-cert-file and --tls-private-key-file must be set to serve TLS", "cert", tlsCertFile, "key", tlsPrivateKeyFile)
+		return 1
+	}
+
+	if demo {
+		level.Warn(logger).Log("msg", "demo mode enabled: objectives without data are shown with empty graphs instead of errors")
+	}
+
+	shutdownTracing, err := setupTracing(context.Background(), otlpEndpoint, otlpInsecure)
+	if err != nil {
+		level.Error(logger).Log("msg", "failed to set up tracing", "err", err)
+		return 1
+	}
+	if otlpEndpoint != "" {
+		level.Info(logger).Log("msg", "exporting traces", "endpoint", otlpEndpoint)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			level.Warn(logger).Log("msg", "failed to flush traces", "err", err)
+		}
+	}()
+
 	build, err := fs.Sub(ui, "ui/build")
 	if err != nil {
 		level.Error(logger).Log("msg", "failed to read UI build files", "err", err)
 		return 1
 	}
 
-	// RoutePrefix must always be at least '/'.
-	routePrefix = "/" + strings.Trim(routePrefix, "/")
-	if uiRoutePrefix == "" {
-		uiRoutePrefix = routePrefix
-	} else {
-		uiRoutePrefix = "/" + strings.Trim(uiRoutePrefix, "/")
-	}
+	routePrefix, uiRoutePrefix = routePrefixes(routePrefix, uiRoutePrefix)
 
 	level.Info(logger).Log("msg", "UI redirect to Prometheus", "url", prometheusExternal.String())
-	level.Info(logger).Log("msg", "using API at", "url", apiURL.String())
+	for _, apiURL := range apiURLs {
+		level.Info(logger).Log("msg", "using API at", "url", apiURL.String())
+	}
 	level.Info(logger).Log("msg", "using route prefix", "prefix", routePrefix)
+	level.Info(logger).Log("msg", "using listen address", "address", listenAddress)
+
+	cacheRequests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pyrra_api_cache_requests_total",
+		Help: "The total amount of query cache lookups by query type and result.",
+	}, []string{"type", "result"})
+	reg.MustRegister(cacheRequests)
+
+	queryDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:                           "pyrra_prometheus_query_duration_seconds",
+		Help:                           "Tracks the latencies of queries sent to Prometheus by query type, excluding cached results.",
+		NativeHistogramBucketFactor:    1.1,
+		NativeHistogramMaxBucketNumber: 100,
+	}, []string{"type"})
+	reg.MustRegister(queryDuration)
 
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1e7,     // number of keys to track frequency of (10M).
-		MaxCost:     1 << 30, // maximum cost of cache (1GB).
-		BufferItems: 64,      // number of keys per Get buffer.
-	})
-	if err != nil {
-		level.Error(logger).Log("msg", "failed to create cache", "err", err)
-		return 1
-	}
-	defer cache.Close()
 	promAPI := &promCache{
 		api: &promLogger{
 			api:    prometheusapiv1.NewAPI(promClient),
 			logger: logger,
 		},
-		cache: cache,
+		cacheRequests: cacheRequests,
+		queryDuration: queryDuration,
+		timeout:       queryTimeout,
+		queryTTL:      cacheConfig.QueryTTL,
+		queryRangeTTL: cacheConfig.QueryRangeTTL,
+		rangeBucket:   cacheConfig.RangeBucket,
+		negativeTTL:   cacheConfig.NegativeTTL,
+		retries:       queryRetries,
+		retryBackoff:  queryRetryBackoff,
+	}
+	if maxConcurrentQueries > 0 {
+		promAPI.queries = make(chan struct{}, maxConcurrentQueries)
+	}
+	if !cacheConfig.Disabled && cacheConfig.MaxSizeBytes > 0 {
+		cache, err := ristretto.NewCache(&ristretto.Config{
+			NumCounters: 1e7,                      // number of keys to track frequency of (10M).
+			MaxCost:     cacheConfig.MaxSizeBytes, // maximum cost of cache.
+			BufferItems: 64,                       // number of keys per Get buffer.
+		})
+		if err != nil {
+			level.Error(logger).Log("msg", "failed to create cache", "err", err)
+			return 1
+		}
+		// Closed after the HTTP server has shut down and drained in-flight requests.
+		defer cache.Close()
+		promAPI.cache = cache
+	} else {
+		level.Info(logger).Log("msg", "query cache disabled")
 	}
 
-	tmpl, err := template.ParseFS(build, "index.html")
+	var secondaryAPI *promCache
+	if secondaryClient != nil {
+		// The secondary Prometheus shares the cache and configuration, its results are told apart by the key prefix.
+		secondary := *promAPI
+		secondary.api = &promLogger{
+			api:    prometheusapiv1.NewAPI(secondaryClient),
+			logger: log.WithPrefix(logger, "prometheus", "secondary"),
+		}
+		secondary.keyPrefix = "secondary;"
+		if maxConcurrentQueries > 0 {
+			secondary.queries = make(chan struct{}, maxConcurrentQueries)
+		}
+		secondaryAPI = &secondary
+	}
+
+	uiFiles, err := uiHandler(logger, build, routePrefix, uiRoutePrefix, prometheusExternal.String(), uiTitle, uiLogoURL)
 	if err != nil {
-		level.Error(logger).Log("msg", "failed to parse HTML template", "err", err)
+		level.Error(logger).Log("msg", "failed to serve UI", "err", err)
 		return 1
 	}
 
 	r := chi.NewRouter()
-	r.Use(cors.Handler(cors.Options{
-		AllowedHeaders: []string{
-			"Content-Type",
-			"Connect-Protocol-Version",
-		},
-	})) // TODO: Disable by default
+	r.Use(accessLog(log.WithPrefix(logger, "component", "http"), routePrefix, accessLogSkipPaths))
+	if len(corsAllowedOrigins) > 0 {
+		level.Info(logger).Log("msg", "enabling CORS", "origins", strings.Join(corsAllowedOrigins, ","))
+		r.Use(cors.Handler(cors.Options{
+			AllowedOrigins: corsAllowedOrigins,
+			AllowedHeaders: []string{
+				"Content-Type",
+				"Connect-Protocol-Version",
+				"Cache-Control",
+			},
+			ExposedHeaders: []string{"Age", partialListHeader},
+		}))
+	}
 
+	// The connect interceptor records the duration of all ObjectiveService and PrometheusService methods.
 	prometheusInterceptor := connectprometheus.NewInterceptor(reg)
+	tracingInterceptor := newTracingInterceptor()
+	cacheAgeInterceptor := newCacheAgeInterceptor()
+
+	// handlerDuration records the duration of the plain HTTP handlers not served by connect.
+	handlerDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:                           "pyrra_api_http_request_duration_seconds",
+		Help:                           "Tracks the latencies of HTTP requests not served by connect by handler and code.",
+		NativeHistogramBucketFactor:    1.1,
+		NativeHistogramMaxBucketNumber: 100,
+	}, []string{"handler", "code"})
+	reg.MustRegister(handlerDuration)
+
+	// rateLimit only limits the API, health checks, metrics and UI files are always served.
+	rateLimit := func(next http.Handler) http.Handler { return next }
+	if apiRateLimit > 0 {
+		level.Info(logger).Log("msg", "rate limiting API requests per client", "limit", apiRateLimit, "burst", apiRateLimitBurst)
+		rateLimited := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "pyrra_api_rate_limited_requests_total",
+			Help: "The total amount of API requests rejected by the rate limit.",
+		})
+		reg.MustRegister(rateLimited)
+		rateLimit = newRateLimiter(rate.Limit(apiRateLimit), apiRateLimitBurst, rateLimited).middleware
+	}
+
+	// Health endpoints are mounted outside the route prefix for probes to not depend on it.
+	r.Get("/healthz", healthzHandler)
+	r.Method(http.MethodGet, "/readyz", instrumentHandler(handlerDuration, "readyz", readyzHandler(logger, promAPI)))
 
 	r.Route(routePrefix, func(r chi.Router) {
 		clientConfig := promconfig.HTTPClientConfig{
@@ -300,75 +712,89 @@ func cmdAPI(
 			Transport: roundTripper,
 		}
 
+		objectivesMatched := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "pyrra_api_objectives_matched",
+			Help:    "The number of objectives matched by the expr of requests for a single objective by procedure.",
+			Buckets: []float64{0, 1, 2, 5, 10, 50},
+		}, []string{"procedure"})
+		reg.MustRegister(objectivesMatched)
+		objectivesZeroTraffic := prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "pyrra_objectives_zero_traffic",
+			Help: "The number of objectives without any requests within their window, e.g. due to a broken metric pipeline. Updated when listing the statuses of all objectives.",
+		})
+		reg.MustRegister(objectivesZeroTraffic)
+
 		objectiveService := &objectiveServer{
-			logger:  log.WithPrefix(logger, "service", "objective"),
-			promAPI: promAPI,
-			client: newBackendClientCache(
+			logger:             log.WithPrefix(logger, "service", "objective"),
+			promAPI:            promAPI,
+			maxQueryResolution: maxQueryResolution,
+			minQueryStep:       minQueryStep,
+			maxQueryRange:      maxQueryRange,
+			rateWindow:         defaultRateWindow,
+			errorBudgetRange:   defaultErrorBudgetRange,
+			queryOffset:        queryOffset,
+			exposeQueries:      exposeQueries,
+			demo:               demo,
+			objectivesMatched:  objectivesMatched,
+			zeroTraffic:        objectivesZeroTraffic,
+		}
+		backends := make([]objectivesv1alpha1connect.ObjectiveBackendServiceClient, 0, len(apiURLs))
+		for _, apiURL := range apiURLs {
+			backends = append(backends, newBackendClientCache(
 				objectivesv1alpha1connect.NewObjectiveBackendServiceClient(
 					client,
 					apiURL.String(),
-					connect.WithInterceptors(prometheusInterceptor),
+					connect.WithInterceptors(prometheusInterceptor, tracingInterceptor),
 				),
-			),
+				backendCacheTTL,
+			))
 		}
+		objectiveService.client = newMultiBackendClient(log.WithPrefix(logger, "service", "backend"), apiURLs, backends)
 
 		objectivePath, objectiveHandler := objectivesv1alpha1connect.NewObjectiveServiceHandler(
 			objectiveService,
-			connect.WithInterceptors(prometheusInterceptor),
+			connect.WithInterceptors(prometheusInterceptor, tracingInterceptor, cacheAgeInterceptor),
 		)
 
 		prometheusService := &prometheusServer{
-			logger:  log.WithPrefix(logger, "service", "prometheus"),
-			promAPI: promAPI,
+			logger:    log.WithPrefix(logger, "service", "prometheus"),
+			promAPI:   promAPI,
+			secondary: secondaryAPI,
 		}
-		prometheusPath, prometheusHandler := prometheusv1connect.NewPrometheusServiceHandler(prometheusService)
+		prometheusPath, prometheusHandler := prometheusv1connect.NewPrometheusServiceHandler(
+			prometheusService,
+			connect.WithInterceptors(tracingInterceptor, cacheAgeInterceptor),
+		)
 
 		if routePrefix != "/" {
-			r.Mount(objectivePath, http.StripPrefix(routePrefix, objectiveHandler))
-			r.Mount(prometheusPath, http.StripPrefix(routePrefix, prometheusHandler))
+			r.With(rateLimit).Mount(objectivePath, http.StripPrefix(routePrefix, objectiveHandler))
+			r.With(rateLimit).Mount(prometheusPath, http.StripPrefix(routePrefix, prometheusHandler))
 		} else {
-			r.Mount(objectivePath, objectiveHandler)
-			r.Mount(prometheusPath, prometheusHandler)
+			r.With(rateLimit).Mount(objectivePath, objectiveHandler)
+			r.With(rateLimit).Mount(prometheusPath, prometheusHandler)
 		}
 
 		r.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
-		r.Get("/objectives", func(w http.ResponseWriter, _ *http.Request) {
-			err := tmpl.Execute(w, struct {
-				PrometheusURL string
-				PathPrefix    string
-				APIBasepath   string
-			}{
-				PrometheusURL: prometheusExternal.String(),
-				PathPrefix:    uiRoutePrefix,
-				APIBasepath:   uiRoutePrefix,
-			})
-			if err != nil {
-				level.Warn(logger).Log("msg", "failed to populate HTML template", "err", err)
-			}
-		})
-		r.Handle("/*", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Trim trailing slash to not care about matching e.g. /pyrra and /pyrra/
-			if r.URL.Path == "/" || strings.TrimSuffix(r.URL.Path, "/") == routePrefix {
-				err := tmpl.Execute(w, struct {
-					PrometheusURL string
-					PathPrefix    string
-					APIBasepath   string
-				}{
-					PrometheusURL: prometheusExternal.String(),
-					PathPrefix:    uiRoutePrefix,
-					APIBasepath:   uiRoutePrefix,
-				})
-				if err != nil {
-					level.Warn(logger).Log("msg", "failed to populate HTML template", "err", err)
-				}
-				return
-			}
-
-			http.StripPrefix(
-				routePrefix,
-				http.FileServer(http.FS(build)),
-			).ServeHTTP(w, r)
-		}))
+		r.With(rateLimit).Method(http.MethodGet, "/api/v1/status", instrumentHandler(handlerDuration, "status", statusHandler(statusResponse{
+			Version:       version,
+			Commit:        commit,
+			GoVersion:     runtime.Version(),
+			PrometheusURL: promClient.URL("", nil).Redacted(),
+			BackendURL:    redactedURLs(apiURLs),
+			CacheEnabled:  promAPI.cache != nil,
+		})))
+		r.With(rateLimit).Method(http.MethodGet, "/api/v1/objectives/{name}/rules.yaml", instrumentHandler(handlerDuration, "rules", rulesFileHandler(
+			log.WithPrefix(logger, "service", "rules"),
+			objectiveService,
+		)))
+		r.With(rateLimit).Method(http.MethodPost, "/api/v1/objectives/validate", instrumentHandler(handlerDuration, "validate", validateHandler(
+			log.WithPrefix(logger, "service", "validate"),
+		)))
+		r.With(rateLimit).Mount("/grafana", instrumentHandler(handlerDuration, "grafana", (&grafanaServer{
+			logger:     log.WithPrefix(logger, "service", "grafana"),
+			objectives: objectiveService,
+		}).routes()))
+		r.Handle("/*", uiFiles)
 	})
 
 	if routePrefix != "/" {
@@ -386,22 +812,64 @@ func cmdAPI(
 
 	{
 		httpServer := &http.Server{
-			Addr:      ":9099",
+			Addr:      listenAddress,
 			Handler:   h2c.NewHandler(r, &http2.Server{}),
 			TLSConfig: &tls.Config{},
 		}
+
+		var certs *certReloader
+		if tlsCertFile != "" {
+			certs, err = newCertReloader(tlsCertFile, tlsPrivateKeyFile)
+			if err != nil {
+				level.Error(logger).Log("msg", "failed to load TLS certificate", "err", err)
+				return 1
+			}
+			httpServer.TLSConfig.GetCertificate = certs.GetCertificate
+
+			// Reload the certificate on SIGHUP for rotated certificates to be served without restarting.
+			hup := make(chan os.Signal, 1)
+			signal.Notify(hup, syscall.SIGHUP)
+			hupCtx, cancel := context.WithCancel(ctx)
+			gr.Add(
+				func() error {
+					for {
+						select {
+						case <-hup:
+							if err := certs.reload(); err != nil {
+								level.Warn(logger).Log("msg", "failed to reload TLS certificate, serving the previous one", "err", err)
+								continue
+							}
+							level.Info(logger).Log("msg", "reloaded TLS certificate", "cert", tlsCertFile, "key", tlsPrivateKeyFile)
+						case <-hupCtx.Done():
+							return nil
+						}
+					}
+				},
+				func(error) {
+					signal.Stop(hup)
+					cancel()
+				},
+			)
+		}
+
 		gr.Add(
 			func() error {
-				if tlsCertFile != "" && tlsPrivateKeyFile != "" {
+				if certs != nil {
 					level.Info(logger).Log("msg", "serving using TLS", "cert", tlsCertFile, "key", tlsPrivateKeyFile)
-					return httpServer.ListenAndServeTLS(tlsCertFile, tlsPrivateKeyFile)
+					// The certificate is served by the reloader instead of being loaded from the files here.
+					return httpServer.ListenAndServeTLS("", "")
 				}
 				return httpServer.ListenAndServe()
 			},
 			func(error) {
-				shutdownCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+				level.Info(logger).Log("msg", "shutting down HTTP server", "timeout", gracefulShutdownTimeout)
+				shutdownCtx, cancel := context.WithTimeout(ctx, gracefulShutdownTimeout)
 				defer cancel()
-				_ = httpServer.Shutdown(shutdownCtx)
+				if err := httpServer.Shutdown(shutdownCtx); err != nil {
+					level.Warn(logger).Log("msg", "failed to gracefully shut down HTTP server", "err", err)
+					return
+				}
+				level.Info(logger).Log("msg", "HTTP server shut down")
 			},
 		)
 	}
@@ -416,170 +884,891 @@ func cmdAPI(
 	return 0
 }
 
-func newBackendClientCache(client objectivesv1alpha1connect.ObjectiveBackendServiceClient) objectivesv1alpha1connect.ObjectiveBackendServiceClient {
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 100,
-		MaxCost:     10 * 1000, // 10 seconds
-		BufferItems: 64,
-	})
-	if err != nil {
-		panic(err)
-	}
-	return &backendClientCache{client: client, cache: cache}
-}
+// certReloader serves the TLS certificate loaded from the files and reloads it on demand.
+type certReloader struct {
+	certFile, keyFile string
 
-type backendClientCache struct {
-	client objectivesv1alpha1connect.ObjectiveBackendServiceClient
-	cache  *ristretto.Cache
+	mu   sync.RWMutex
+	cert *tls.Certificate
 }
 
-// List calls the backend service and caches the result for 10 seconds if the request is successful.
-func (b *backendClientCache) List(ctx context.Context, req *connect.Request[objectivesv1alpha1.ListRequest]) (*connect.Response[objectivesv1alpha1.ListResponse], error) {
-	key := req.Msg.Expr + req.Msg.Grouping
-
-	list, found := b.cache.Get(key)
-	if found {
-		return connect.NewResponse(list.(*objectivesv1alpha1.ListResponse)), nil
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	if err := r.reload(); err != nil {
+		return nil, err
 	}
+	return r, nil
+}
 
-	start := time.Now()
-	resp, err := b.client.List(ctx, req)
+// reload loads the certificate from the files again. The previous certificate is kept if that fails.
+func (r *certReloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
 	if err != nil {
-		return nil, err
+		return fmt.Errorf("failed to load key pair: %w", err)
 	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	return nil
+}
 
-	_ = b.cache.SetWithTTL(key, resp.Msg, time.Since(start).Milliseconds(), 10*time.Second)
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
 
-	return resp, nil
+type healthResponse struct {
+	Status     string `json:"status"`
+	Prometheus string `json:"prometheus,omitempty"`
 }
 
-func newThanosClient(client api.Client) api.Client {
-	return &thanosClient{client: client}
+func writeHealthResponse(w http.ResponseWriter, code int, resp healthResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
 }
 
-// thanosClient wraps the Prometheus Client to inject some headers to disable partial responses
-// and enables querying for downsampled data.
-type thanosClient struct {
-	client api.Client
+// healthzHandler always returns 200 as long as the HTTP server is running.
+func healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	writeHealthResponse(w, http.StatusOK, healthResponse{Status: "ok"})
 }
 
-func (c *thanosClient) URL(ep string, args map[string]string) *url.URL {
-	return c.client.URL(ep, args)
+// readyzHandler returns 503 if Prometheus can't be queried.
+func readyzHandler(logger log.Logger, promAPI *promCache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := promAPI.ping(r.Context()); err != nil {
+			level.Warn(logger).Log("msg", "readiness check failed", "err", err)
+			writeHealthResponse(w, http.StatusServiceUnavailable, healthResponse{
+				Status:     "unavailable",
+				Prometheus: err.Error(),
+			})
+			return
+		}
+		writeHealthResponse(w, http.StatusOK, healthResponse{Status: "ok", Prometheus: "ok"})
+	}
 }
 
-func (c *thanosClient) Do(ctx context.Context, r *http.Request) (*http.Response, []byte, error) {
-	if r.Body == nil {
-		return c.client.Do(ctx, r)
+// routePrefixes normalizes the route prefixes to start with a slash and have no trailing slash,
+// except for the root route prefix, which is always '/'.
+// The UI route prefix defaults to the route prefix.
+func routePrefixes(routePrefix, uiRoutePrefix string) (string, string) {
+	routePrefix = "/" + strings.Trim(routePrefix, "/")
+	if uiRoutePrefix == "" {
+		return routePrefix, routePrefix
 	}
-	body, err := io.ReadAll(r.Body)
+	return routePrefix, "/" + strings.Trim(uiRoutePrefix, "/")
+}
+
+// uiHandler serves the UI below the route prefix.
+// The index.html template is rendered for the route prefix itself and /objectives, with or without trailing slash.
+// Its links and API requests use the UI route prefix, which differs from the route prefix
+// if a proxy strips the prefix before requests reach Pyrra.
+// The title and logo URL allow white-labeling the UI, an empty logo URL keeps Pyrra's logo.
+// All other requests are served from the UI build with the route prefix stripped.
+func uiHandler(logger log.Logger, build fs.FS, routePrefix, uiRoutePrefix, prometheusURL, title, logoURL string) (http.Handler, error) {
+	files, err := uiFileServer(build)
 	if err != nil {
-		return nil, nil, fmt.Errorf("reading body: %w", err)
+		return nil, err
 	}
-	query, err := url.ParseQuery(string(body))
+	tmpl, err := template.ParseFS(build, "index.html")
 	if err != nil {
-		return nil, nil, fmt.Errorf("parsing body: %w", err)
+		return nil, fmt.Errorf("failed to parse HTML template: %w", err)
+	}
+	data := struct {
+		PrometheusURL string
+		PathPrefix    string
+		APIBasepath   string
+		Title         string
+		LogoURL       string
+	}{
+		// The UI appends paths like /graph, Prometheus served with a path prefix might be configured with a trailing slash.
+		PrometheusURL: strings.TrimSuffix(prometheusURL, "/"),
+		PathPrefix:    uiRoutePrefix,
+		APIBasepath:   uiRoutePrefix,
+		Title:         title,
+		LogoURL:       logoURL,
 	}
 
-	// We don't want partial responses, especially not when calculating error budgets.
-	query.Set("partial_response", "false")
-	r.ContentLength += 23
+	// The root route prefix '/' becomes empty to be joined with the paths below.
+	prefix := strings.TrimSuffix(routePrefix, "/")
+	files = http.StripPrefix(prefix, files)
 
-	if strings.HasSuffix(r.URL.Path, "/api/v1/query_range") {
-		start, err := strconv.ParseFloat(query.Get("start"), 64)
-		if err != nil {
-			return nil, nil, fmt.Errorf("parsing start: %w", err)
-		}
-		end, err := strconv.ParseFloat(query.Get("end"), 64)
-		if err != nil {
-			return nil, nil, fmt.Errorf("parsing end: %w", err)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimSuffix(r.URL.Path, "/") {
+		case prefix, prefix + "/objectives":
+			w.Header().Set("Cache-Control", "no-cache")
+			if err := tmpl.Execute(w, data); err != nil {
+				level.Warn(logger).Log("msg", "failed to populate HTML template", "err", err)
+			}
+		default:
+			files.ServeHTTP(w, r)
 		}
+	}), nil
+}
 
-		if end-start >= 28*24*60*60 { // request 1h downsamples when range > 28d
-			query.Set("max_source_resolution", "1h")
-			r.ContentLength += 25
-		} else if end-start >= 7*24*60*60 { // request 5m downsamples when range > 1w
-			query.Set("max_source_resolution", "5m")
-			r.ContentLength += 25
+// hashedAsset matches the files of the UI build with a content hash in their name, like static/js/main.1a2b3c4d.js.
+var hashedAsset = regexp.MustCompile(`\.[0-9a-f]{8,}\.[a-z0-9]+$`)
+
+// uiFileServer serves the embedded UI build with an ETag derived from each file's content.
+// Files with a content hash in their name never change and are cached for a year,
+// all other files have to be revalidated by browsers.
+func uiFileServer(build fs.FS) (http.Handler, error) {
+	etags := map[string]string{}
+	err := fs.WalkDir(build, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := fs.ReadFile(build, path)
+		if err != nil {
+			return err
 		}
+		sum := sha256.Sum256(content)
+		etags["/"+path] = `"` + hex.EncodeToString(sum[:8]) + `"`
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash UI files: %w", err)
 	}
 
-	encoded := query.Encode()
-	r.Body = io.NopCloser(strings.NewReader(encoded))
-	return c.client.Do(ctx, r)
+	files := http.FileServer(http.FS(build))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Stripping the route prefix might have removed the leading slash.
+		path := "/" + strings.TrimPrefix(r.URL.Path, "/")
+		if etag, ok := etags[path]; ok {
+			// The file server responds with 304 Not Modified if the ETag matches If-None-Match.
+			w.Header().Set("ETag", etag)
+			if hashedAsset.MatchString(path) {
+				w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+			} else {
+				w.Header().Set("Cache-Control", "no-cache")
+			}
+		}
+		files.ServeHTTP(w, r)
+	}), nil
 }
 
-type prometheusAPI interface {
-	// Query performs a query for the given time.
-	Query(ctx context.Context, query string, ts time.Time, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error)
-	// QueryRange performs a query for the given range.
-	QueryRange(ctx context.Context, query string, r prometheusapiv1.Range, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error)
+type statusResponse struct {
+	Version       string `json:"version"`
+	Commit        string `json:"commit"`
+	GoVersion     string `json:"goVersion"`
+	PrometheusURL string `json:"prometheusURL"`
+	BackendURL    string `json:"backendURL"`
+	CacheEnabled  bool   `json:"cacheEnabled"`
 }
 
-type promLogger struct {
-	api    prometheusAPI
-	logger log.Logger
+// statusHandler returns the build information and configuration of the running Pyrra.
+func statusHandler(status statusResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(status)
+	}
 }
 
-func (l *promLogger) Query(ctx context.Context, query string, ts time.Time, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
-	level.Debug(l.logger).Log(
-		"msg", "running instant query",
-		"query", query,
-		"ts", ts,
-	)
-	return l.api.Query(ctx, query, ts, opts...)
+// instrumentHandler observes the duration of each request to the handler.
+func instrumentHandler(duration *prometheus.HistogramVec, name string, handler http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerDuration(duration.MustCurryWith(prometheus.Labels{"handler": name}), handler)
 }
 
-func (l *promLogger) QueryRange(ctx context.Context, query string, r prometheusapiv1.Range, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
-	level.Debug(l.logger).Log(
-		"msg", "running range query",
-		"query", query,
-		"start", r.Start,
-		"end", r.End,
-	)
-	return l.api.QueryRange(ctx, query, r, opts...)
-}
+// accessLog returns a middleware logging each request's method, path, status, response size and duration.
+// Requests for skipPaths, with or without the routePrefix, aren't logged.
+func accessLog(logger log.Logger, routePrefix string, skipPaths []string) func(http.Handler) http.Handler {
+	skip := make(map[string]bool, len(skipPaths))
+	for _, p := range skipPaths {
+		skip[p] = true
+	}
+	prefix := strings.TrimSuffix(routePrefix, "/")
 
-type promCache struct {
-	api   prometheusAPI
-	cache *ristretto.Cache
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if skip[r.URL.Path] || (prefix != "" && skip[strings.TrimPrefix(r.URL.Path, prefix)]) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK // Nothing was written explicitly.
+			}
+			level.Info(logger).Log(
+				"msg", "handled request",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", status,
+				"size", ww.BytesWritten(),
+				"duration", time.Since(start),
+			)
+		})
+	}
 }
 
-type promCacheKeyType string
+// rateLimiter limits the requests of each client, identified by its IP, with a token bucket.
+// Clients behind the same proxy share their limit.
+type rateLimiter struct {
+	limit   rate.Limit
+	burst   int
+	limited prometheus.Counter
 
-const promCacheKey promCacheKeyType = "promCache"
+	mu          sync.Mutex
+	clients     map[string]*rateLimiterClient
+	lastCleanup time.Time
+}
 
-func contextSetPromCache(ctx context.Context, t time.Duration) context.Context {
-	return context.WithValue(ctx, promCacheKey, t)
+type rateLimiterClient struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
 }
 
-func contextGetPromCache(ctx context.Context) time.Duration {
-	t, ok := ctx.Value(promCacheKey).(time.Duration)
-	if ok {
-		return t
+func newRateLimiter(limit rate.Limit, burst int, limited prometheus.Counter) *rateLimiter {
+	return &rateLimiter{
+		limit:       limit,
+		burst:       burst,
+		limited:     limited,
+		clients:     map[string]*rateLimiterClient{},
+		lastCleanup: time.Now(),
 	}
-	return 0
 }
 
-func (p *promCache) Query(ctx context.Context, query string, ts time.Time) (model.Value, prometheusapiv1.Warnings, error) {
-	if value, exists := p.cache.Get(query); exists {
-		return value.(model.Value), nil, nil
+// middleware responds with 429 Too Many Requests and a Retry-After header once a client exceeds its limit.
+func (l *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			client = r.RemoteAddr
+		}
+
+		now := time.Now()
+		reservation := l.limiter(client, now).ReserveN(now, 1)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			l.limited.Inc()
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// limiter returns the client's limiter and forgets clients that have been idle long enough to have a full bucket again.
+func (l *rateLimiter) limiter(client string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	idle := max(time.Minute, time.Duration(float64(l.burst)/float64(l.limit)*float64(time.Second)))
+	if now.Sub(l.lastCleanup) > idle {
+		for k, c := range l.clients {
+			if now.Sub(c.lastSeen) > idle {
+				delete(l.clients, k)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	c, ok := l.clients[client]
+	if !ok {
+		c = &rateLimiterClient{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[client] = c
+	}
+	c.lastSeen = now
+	return c.limiter
+}
+
+// newBackendClientCache returns a client caching the backend's successful responses for the ttl.
+// The client isn't wrapped if the ttl is 0.
+func newBackendClientCache(client objectivesv1alpha1connect.ObjectiveBackendServiceClient, ttl time.Duration) objectivesv1alpha1connect.ObjectiveBackendServiceClient {
+	if ttl <= 0 {
+		return client
+	}
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 100,
+		MaxCost:     10 * 1000, // 10 seconds
+		BufferItems: 64,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &backendClientCache{client: client, cache: cache, ttl: ttl}
+}
+
+type backendClientCache struct {
+	client objectivesv1alpha1connect.ObjectiveBackendServiceClient
+	cache  *ristretto.Cache
+	ttl    time.Duration
+}
+
+type backendCacheKeyType string
+
+const backendCacheKey backendCacheKeyType = "backendCache"
+
+// contextBypassBackendCache makes the backendClientCache always call the backend.
+// The fresh response is still cached for subsequent requests.
+func contextBypassBackendCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, backendCacheKey, true)
+}
+
+// List calls the backend service and caches the result for the ttl if the request is successful.
+func (b *backendClientCache) List(ctx context.Context, req *connect.Request[objectivesv1alpha1.ListRequest]) (*connect.Response[objectivesv1alpha1.ListResponse], error) {
+	key := req.Msg.Expr + req.Msg.Grouping
+
+	if bypass, _ := ctx.Value(backendCacheKey).(bool); !bypass {
+		list, found := b.cache.Get(key)
+		if found {
+			return connect.NewResponse(list.(*objectivesv1alpha1.ListResponse)), nil
+		}
 	}
 
 	start := time.Now()
-	value, warnings, err := p.api.Query(ctx, query, ts)
-	duration := time.Since(start)
+	resp, err := b.client.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = b.cache.SetWithTTL(key, resp.Msg, time.Since(start).Milliseconds(), b.ttl)
+
+	return resp, nil
+}
+
+// newMultiBackendClient returns a client listing the objectives of all backends.
+// A single backend is returned as is.
+func newMultiBackendClient(logger log.Logger, urls []*url.URL, backends []objectivesv1alpha1connect.ObjectiveBackendServiceClient) objectivesv1alpha1connect.ObjectiveBackendServiceClient {
+	if len(backends) == 1 {
+		return backends[0]
+	}
+	return &multiBackendClient{logger: logger, urls: urls, backends: backends}
+}
+
+// multiBackendClient aggregates the objectives of multiple backends, e.g. a filesystem and a Kubernetes operator.
+type multiBackendClient struct {
+	logger   log.Logger
+	urls     []*url.URL
+	backends []objectivesv1alpha1connect.ObjectiveBackendServiceClient
+}
+
+// partialListHeader is set on list responses missing the objectives of failed backends.
+// Callers must not treat an objective missing from such a list as non-existent.
+const partialListHeader = "Pyrra-Partial-List"
+
+// partialList returns true if the list response misses the objectives of failed backends.
+func partialList(header http.Header) bool {
+	return header.Get(partialListHeader) == "true"
+}
+
+// noObjectiveError is returned if a list response contains no objective matching the requested expr.
+// If backends failed to list their objectives, the objective might still exist, which is reported as unavailable.
+func noObjectiveError(header http.Header) error {
+	if partialList(header) {
+		return connect.NewError(connect.CodeUnavailable, fmt.Errorf("expr matches no SLO of the available backends"))
+	}
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("expr matches no SLO"))
+}
+
+// List calls all backends concurrently and merges their objectives, skipping objectives with the same labels.
+// Backends failing are logged and skipped, only if all backends fail the first error is returned.
+// Otherwise, the response of a list missing some backends' objectives has the partialListHeader set.
+func (m *multiBackendClient) List(ctx context.Context, req *connect.Request[objectivesv1alpha1.ListRequest]) (*connect.Response[objectivesv1alpha1.ListResponse], error) {
+	responses := make([]*connect.Response[objectivesv1alpha1.ListResponse], len(m.backends))
+	errs := make([]error, len(m.backends))
+
+	var wg sync.WaitGroup
+	for i, backend := range m.backends {
+		wg.Add(1)
+		go func(i int, backend objectivesv1alpha1connect.ObjectiveBackendServiceClient) {
+			defer wg.Done()
+			responses[i], errs[i] = backend.List(ctx, connect.NewRequest(req.Msg))
+		}(i, backend)
+	}
+	wg.Wait()
+
+	var (
+		seen       = map[string]bool{}
+		objectives []*objectivesv1alpha1.Objective
+		failed     int
+	)
+	for i, resp := range responses {
+		if errs[i] != nil {
+			failed++
+			level.Warn(m.logger).Log("msg", "failed to list objectives", "url", m.urls[i].Redacted(), "err", errs[i])
+			continue
+		}
+		for _, o := range resp.Msg.Objectives {
+			key := labels.FromMap(o.Labels).String()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			objectives = append(objectives, o)
+		}
+	}
+	if failed == len(m.backends) {
+		return nil, errs[0]
+	}
+
+	resp := connect.NewResponse(&objectivesv1alpha1.ListResponse{Objectives: objectives})
+	if failed > 0 {
+		resp.Header().Set(partialListHeader, "true")
+	}
+	return resp, nil
+}
+
+// redactedURLs returns the URLs separated by comma with their passwords redacted.
+func redactedURLs(urls []*url.URL) string {
+	redacted := make([]string, 0, len(urls))
+	for _, u := range urls {
+		redacted = append(redacted, u.Redacted())
+	}
+	return strings.Join(redacted, ",")
+}
+
+func newThanosClient(client api.Client) api.Client {
+	return &thanosClient{client: client}
+}
+
+// thanosClient wraps the Prometheus Client to inject some headers to disable partial responses
+// and enables querying for downsampled data.
+type thanosClient struct {
+	client api.Client
+}
+
+func (c *thanosClient) URL(ep string, args map[string]string) *url.URL {
+	return c.client.URL(ep, args)
+}
+
+func (c *thanosClient) Do(ctx context.Context, r *http.Request) (*http.Response, []byte, error) {
+	if r.Body == nil {
+		return c.client.Do(ctx, r)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading body: %w", err)
+	}
+	query, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing body: %w", err)
+	}
+
+	// We don't want partial responses, especially not when calculating error budgets.
+	query.Set("partial_response", "false")
+	r.ContentLength += 23
+
+	// Only match the last path segment, Prometheus might be served with a path prefix or behind a proxy rewriting the API paths.
+	if path.Base(r.URL.Path) == "query_range" {
+		start, err := strconv.ParseFloat(query.Get("start"), 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing start: %w", err)
+		}
+		end, err := strconv.ParseFloat(query.Get("end"), 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing end: %w", err)
+		}
+
+		if end-start >= 28*24*60*60 { // request 1h downsamples when range > 28d
+			query.Set("max_source_resolution", "1h")
+			r.ContentLength += 25
+		} else if end-start >= 7*24*60*60 { // request 5m downsamples when range > 1w
+			query.Set("max_source_resolution", "5m")
+			r.ContentLength += 25
+		}
+	}
+
+	encoded := query.Encode()
+	r.Body = io.NopCloser(strings.NewReader(encoded))
+	return c.client.Do(ctx, r)
+}
+
+// gzipRoundTripper requests gzip compressed responses and transparently decompresses them.
+// The round trippers of prometheus/common disable the transparent compression of Go's transport,
+// yet large range query responses compress very well.
+type gzipRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rt *gzipRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.Header.Get("Accept-Encoding") != "" {
+		// The caller handles the encoding itself.
+		return rt.next.RoundTrip(r)
+	}
+
+	// Round trippers must not modify the original request.
+	r = r.Clone(r.Context())
+	r.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := rt.next.RoundTrip(r)
+	if err != nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, err
+	}
+
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// gzipBody decompresses the body lazily on the first read, like Go's transport, for empty bodies to not fail early.
+type gzipBody struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	err  error
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.zr == nil {
+		if b.err == nil {
+			b.zr, b.err = gzip.NewReader(b.body)
+		}
+		if b.err != nil {
+			return 0, b.err
+		}
+	}
+	return b.zr.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
+
+type prometheusAPI interface {
+	// Query performs a query for the given time.
+	Query(ctx context.Context, query string, ts time.Time, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error)
+	// QueryRange performs a query for the given range.
+	QueryRange(ctx context.Context, query string, r prometheusapiv1.Range, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error)
+}
+
+type promLogger struct {
+	api    prometheusAPI
+	logger log.Logger
+}
+
+func (l *promLogger) Query(ctx context.Context, query string, ts time.Time, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
+	level.Debug(l.logger).Log(
+		"msg", "running instant query",
+		"query", query,
+		"ts", ts,
+	)
+	return l.api.Query(ctx, query, ts, opts...)
+}
+
+func (l *promLogger) QueryRange(ctx context.Context, query string, r prometheusapiv1.Range, opts ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
+	level.Debug(l.logger).Log(
+		"msg", "running range query",
+		"query", query,
+		"start", r.Start,
+		"end", r.End,
+	)
+	return l.api.QueryRange(ctx, query, r, opts...)
+}
+
+type promCache struct {
+	api   prometheusAPI
+	cache *ristretto.Cache // nil if caching is disabled.
+
+	// keyPrefix is prepended to all cache keys, for multiple Prometheus to share the same cache.
+	keyPrefix string
+
+	// timeout limits the duration of each query against Prometheus, 0 disables it.
+	timeout time.Duration
+
+	// cacheRequests counts cache lookups by query type and result.
+	cacheRequests *prometheus.CounterVec
+
+	// queryDuration observes the duration of each query sent to Prometheus by query type, nil doesn't observe them.
+	queryDuration *prometheus.HistogramVec
+
+	// queryTTL and queryRangeTTL override the cache durations requested via the context if set.
+	queryTTL      time.Duration
+	queryRangeTTL time.Duration
+
+	// rangeBucket truncates the start and end of range queries for their cache keys,
+	// for ranges within the same buckets to share cached results. 0 truncates them to the query's step.
+	rangeBucket time.Duration
+
+	// negativeTTL is how long empty results are cached, 0 disables caching them.
+	negativeTTL time.Duration
+
+	// queries is a semaphore limiting the concurrent queries against Prometheus, nil doesn't limit them.
+	queries chan struct{}
+
+	// retries is how often queries failing with transient errors are retried after waiting retryBackoff,
+	// which doubles after each retry.
+	retries      int
+	retryBackoff time.Duration
+}
+
+// observeQuery records the duration of a query against Prometheus that started at start.
+func (p *promCache) observeQuery(typ string, start time.Time) {
+	if p.queryDuration != nil {
+		p.queryDuration.WithLabelValues(typ).Observe(time.Since(start).Seconds())
+	}
+}
+
+// retry runs the query until it succeeds, fails with a non-transient error or the retries are used up.
+// Each attempt acquires its own slot and is limited by the timeout.
+// The backoff doubles after each attempt and retrying stops early if the request's deadline would pass while waiting.
+func (p *promCache) retry(ctx context.Context, query func(context.Context) (model.Value, prometheusapiv1.Warnings, error)) (model.Value, prometheusapiv1.Warnings, error) {
+	backoff := p.retryBackoff
+	for attempt := 0; ; attempt++ {
+		value, warnings, err := p.attempt(ctx, query)
+		if err == nil || attempt >= p.retries || !retryableQueryError(err) {
+			return value, warnings, err
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return value, warnings, err
+		}
+
+		trace.SpanFromContext(ctx).AddEvent("retry", trace.WithAttributes(
+			attribute.Int("attempt", attempt+1),
+			attribute.String("error", err.Error()),
+		))
+		select {
+		case <-ctx.Done():
+			return value, warnings, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (p *promCache) attempt(ctx context.Context, query func(context.Context) (model.Value, prometheusapiv1.Warnings, error)) (model.Value, prometheusapiv1.Warnings, error) {
+	release, err := p.acquire(ctx)
 	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	return query(ctx)
+}
+
+// retryableQueryError returns true for errors that might go away when querying again,
+// like 5xx responses and network errors, but not for timeouts and canceled requests.
+func retryableQueryError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *prometheusapiv1.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Type == prometheusapiv1.ErrServer
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// acquire blocks until another query may be sent to Prometheus.
+// The returned function must be called to release the slot once the query is done.
+func (p *promCache) acquire(ctx context.Context) (func(), error) {
+	if p.queries == nil {
+		return func() {}, nil
+	}
+	select {
+	case p.queries <- struct{}{}:
+		return func() { <-p.queries }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ping queries Prometheus directly, bypassing the cache to not count each readiness probe as a cache miss.
+func (p *promCache) ping(ctx context.Context) error {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	if _, _, err := p.api.Query(ctx, "vector(1)", time.Now()); err != nil {
+		return fmt.Errorf("prometheus query: %w", err)
+	}
+	return nil
+}
+
+type promCacheConfig struct {
+	Disabled      bool
+	MaxSizeBytes  int64
+	QueryTTL      time.Duration
+	QueryRangeTTL time.Duration
+	RangeBucket   time.Duration
+	NegativeTTL   time.Duration
+}
+
+type promCacheKeyType string
+
+const promCacheKey promCacheKeyType = "promCache"
+
+func contextSetPromCache(ctx context.Context, t time.Duration) context.Context {
+	return context.WithValue(ctx, promCacheKey, t)
+}
+
+func contextGetPromCache(ctx context.Context) time.Duration {
+	t, ok := ctx.Value(promCacheKey).(time.Duration)
+	if ok {
+		return t
+	}
+	return 0
+}
+
+const cacheAgeKey promCacheKeyType = "cacheAge"
+
+// cacheAge records the age of the oldest cached query result used to respond to a request.
+type cacheAge struct {
+	mu  sync.Mutex
+	age time.Duration
+}
+
+func contextWithCacheAge(ctx context.Context) (context.Context, *cacheAge) {
+	a := &cacheAge{}
+	return context.WithValue(ctx, cacheAgeKey, a), a
+}
+
+// contextObserveCacheAge records the age of a cached query result, if the context records cache ages.
+func contextObserveCacheAge(ctx context.Context, age time.Duration) {
+	a, ok := ctx.Value(cacheAgeKey).(*cacheAge)
+	if !ok {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if age > a.age {
+		a.age = age
+	}
+}
+
+func (a *cacheAge) get() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.age
+}
+
+const cacheBypassKey promCacheKeyType = "cacheBypass"
+
+// contextSetPromCacheBypass makes promCache query Prometheus instead of returning cached results.
+// The fresh results are still cached for other requests.
+func contextSetPromCacheBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey, true)
+}
+
+func contextGetPromCacheBypass(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey).(bool)
+	return bypass
+}
+
+const cacheNowKey promCacheKeyType = "cacheNow"
+
+// contextSetPromCacheNow marks queries as evaluated at the current time rather than at a requested one.
+// Their results are shared by all queries within the cache duration, as they would be served until expiry anyway.
+func contextSetPromCacheNow(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheNowKey, true)
+}
+
+func contextGetPromCacheNow(ctx context.Context) bool {
+	now, _ := ctx.Value(cacheNowKey).(bool)
+	return now
+}
+
+// noCache returns true if the Cache-Control header of a request asks for a response without cached results.
+func noCache(header http.Header) bool {
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), "no-cache") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newCacheAgeInterceptor sets the Age header of responses to the age in seconds of the oldest cached query result used.
+// Cached results are served until their TTL expires, even if Prometheus would by now respond with warnings,
+// the header lets clients know how stale a response may be. Responses without cached results have no Age header.
+// Requests with a Cache-Control: no-cache header are answered with fresh query results.
+func newCacheAgeInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if req.Spec().IsClient {
+				return next(ctx, req)
+			}
+
+			if noCache(req.Header()) {
+				ctx = contextSetPromCacheBypass(ctx)
+			}
+			ctx, age := contextWithCacheAge(ctx)
+			resp, err := next(ctx, req)
+			if err == nil && resp != nil {
+				if a := age.get(); a > 0 {
+					resp.Header().Set("Age", strconv.FormatInt(int64(a/time.Second), 10))
+				}
+			}
+			return resp, err
+		}
+	}
+}
+
+// promCacheEntry is a cached query result with the time it was queried at.
+type promCacheEntry struct {
+	value  model.Value
+	stored time.Time
+}
+
+func (p *promCache) Query(ctx context.Context, query string, ts time.Time) (model.Value, prometheusapiv1.Warnings, error) {
+	cacheDuration := contextGetPromCache(ctx)
+	if cacheDuration > 0 && p.queryTTL > 0 {
+		cacheDuration = p.queryTTL
+	}
+
+	// Include the evaluation time in the key as the same query returns different results over time.
+	// We round by 10s to adjust for small imperfections to increase cache hits.
+	keyTime := ts.Round(10 * time.Second)
+	if contextGetPromCacheNow(ctx) && cacheDuration > 10*time.Second {
+		// Queries evaluated at now share their results within the cache duration,
+		// a result would be served for that long anyway. Explicitly requested times keep their own results.
+		keyTime = ts.Truncate(cacheDuration)
+	}
+	cacheKey := fmt.Sprintf("%s%d;%s", p.keyPrefix, keyTime.Unix(), query)
+
+	ctx, span := tracer.Start(ctx, "promCache.Query", trace.WithAttributes(attribute.String("query", query)))
+	defer span.End()
+
+	if p.cache != nil && !contextGetPromCacheBypass(ctx) {
+		if cached, exists := p.cache.Get(cacheKey); exists {
+			p.cacheRequests.WithLabelValues("query", "hit").Inc()
+			span.SetAttributes(attribute.Bool("cache.hit", true))
+			entry := cached.(promCacheEntry)
+			contextObserveCacheAge(ctx, time.Since(entry.stored))
+			return entry.value, nil, nil
+		}
+		p.cacheRequests.WithLabelValues("query", "miss").Inc()
+	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
+
+	value, warnings, err := p.retry(ctx, func(ctx context.Context) (model.Value, prometheusapiv1.Warnings, error) {
+		defer p.observeQuery("query", time.Now())
+		return p.api.Query(ctx, query, ts)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, warnings, fmt.Errorf("prometheus query: %w", err)
 	}
 	if len(warnings) > 0 {
 		return value, warnings, nil
 	}
 
-	cacheDuration := contextGetPromCache(ctx)
-	if cacheDuration > 0 {
+	if p.cache != nil && cacheDuration > 0 {
 		if v, ok := value.(model.Vector); ok {
 			if len(v) > 0 {
-				_ = p.cache.SetWithTTL(query, value, duration.Milliseconds(), cacheDuration)
+				_ = p.cache.SetWithTTL(cacheKey, promCacheEntry{value: value, stored: time.Now()}, valueCost(value), cacheDuration)
+			} else if p.negativeTTL > 0 {
+				// Cache empty results briefly to not query Prometheus on every refresh for objectives without data.
+				// They are never cached for longer than requested, e.g. for alerts to show up as soon as they fire.
+				_ = p.cache.SetWithTTL(cacheKey, promCacheEntry{value: value, stored: time.Now()}, valueCost(value), min(p.negativeTTL, cacheDuration))
 			}
 		}
 	}
@@ -588,19 +1777,33 @@ func (p *promCache) Query(ctx context.Context, query string, ts time.Time) (mode
 }
 
 func (p *promCache) QueryRange(ctx context.Context, query string, r prometheusapiv1.Range) (model.Value, prometheusapiv1.Warnings, error) {
-	// Get the full time range of this query from start to end.
-	// We round by 10s to adjust for small imperfections to increase cache hits.
-	timeRange := r.End.Sub(r.Start).Round(10 * time.Second)
-	cacheKey := fmt.Sprintf("%d;%s", timeRange.Milliseconds(), query)
-
-	if value, exists := p.cache.Get(cacheKey); exists {
-		return value.(model.Value), nil, nil
+	cacheKey := p.rangeCacheKey(query, r)
+
+	ctx, span := tracer.Start(ctx, "promCache.QueryRange", trace.WithAttributes(
+		attribute.String("query", query),
+		attribute.String("step", r.Step.String()),
+	))
+	defer span.End()
+
+	if p.cache != nil && !contextGetPromCacheBypass(ctx) {
+		if cached, exists := p.cache.Get(cacheKey); exists {
+			p.cacheRequests.WithLabelValues("query_range", "hit").Inc()
+			span.SetAttributes(attribute.Bool("cache.hit", true))
+			entry := cached.(promCacheEntry)
+			contextObserveCacheAge(ctx, time.Since(entry.stored))
+			return entry.value, nil, nil
+		}
+		p.cacheRequests.WithLabelValues("query_range", "miss").Inc()
 	}
+	span.SetAttributes(attribute.Bool("cache.hit", false))
 
-	start := time.Now()
-	value, warnings, err := p.api.QueryRange(ctx, query, r)
-	duration := time.Since(start)
+	value, warnings, err := p.retry(ctx, func(ctx context.Context) (model.Value, prometheusapiv1.Warnings, error) {
+		defer p.observeQuery("query_range", time.Now())
+		return p.api.QueryRange(ctx, query, r)
+	})
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, warnings, fmt.Errorf("prometheus query range: %w", err)
 	}
 	if len(warnings) > 0 {
@@ -608,10 +1811,15 @@ func (p *promCache) QueryRange(ctx context.Context, query string, r prometheusap
 	}
 
 	cacheDuration := contextGetPromCache(ctx)
-	if cacheDuration > 0 {
+	if cacheDuration > 0 && p.queryRangeTTL > 0 {
+		cacheDuration = p.queryRangeTTL
+	}
+	if p.cache != nil && cacheDuration > 0 {
 		if m, ok := value.(model.Matrix); ok {
 			if len(m) > 0 {
-				_ = p.cache.SetWithTTL(cacheKey, value, duration.Milliseconds(), cacheDuration)
+				_ = p.cache.SetWithTTL(cacheKey, promCacheEntry{value: value, stored: time.Now()}, valueCost(value), cacheDuration)
+			} else if p.negativeTTL > 0 {
+				_ = p.cache.SetWithTTL(cacheKey, promCacheEntry{value: value, stored: time.Now()}, valueCost(value), min(p.negativeTTL, cacheDuration))
 			}
 		}
 	}
@@ -619,13 +1827,218 @@ func (p *promCache) QueryRange(ctx context.Context, query string, r prometheusap
 	return value, warnings, nil
 }
 
+// rangeCacheKey returns the cache key of the range query.
+// Start and end are truncated to the range bucket, for consecutive refreshes of the same range to hit the cache,
+// while ranges further apart, e.g. looking at a past incident, don't share results.
+func (p *promCache) rangeCacheKey(query string, r prometheusapiv1.Range) string {
+	bucket := p.rangeBucket
+	if bucket <= 0 {
+		bucket = r.Step
+	}
+	return fmt.Sprintf("%s%d;%d;%d;%s",
+		p.keyPrefix,
+		r.Start.Truncate(bucket).UnixMilli(),
+		r.End.Truncate(bucket).UnixMilli(),
+		r.Step.Milliseconds(),
+		query,
+	)
+}
+
+// Rough in-memory sizes in bytes to estimate the cost of cached values.
+const (
+	seriesCost    = 64 // The series' struct, slice and map headers.
+	labelCost     = 32 // The string headers of a label's name and value.
+	sampleCost    = 16 // A timestamp and value.
+	histogramCost = 48 // A native histogram's count, sum and slice header.
+	bucketCost    = 32 // A native histogram bucket's boundaries, bounds and count.
+)
+
+// valueCost estimates the memory used by the value in bytes.
+// It's used as the cost of cached values, for the cache's maximum size to reflect the actual memory used.
+// For example, a range query over a year returns many more samples than a query over an hour.
+func valueCost(value model.Value) int64 {
+	var cost int64
+	switch v := value.(type) {
+	case model.Vector:
+		for _, s := range v {
+			cost += seriesCost + metricCost(s.Metric) + sampleCost
+			if s.Histogram != nil {
+				cost += histogramCost + int64(len(s.Histogram.Buckets))*bucketCost
+			}
+		}
+	case model.Matrix:
+		for _, s := range v {
+			cost += seriesCost + metricCost(s.Metric) + int64(len(s.Values))*sampleCost
+			for _, h := range s.Histograms {
+				cost += sampleCost + histogramCost
+				if h.Histogram != nil {
+					cost += int64(len(h.Histogram.Buckets)) * bucketCost
+				}
+			}
+		}
+	case *model.Scalar:
+		cost = sampleCost
+	case *model.String:
+		cost = sampleCost + int64(len(v.Value))
+	}
+	// Empty results still take up some memory and must not be free to cache.
+	if cost == 0 {
+		cost = seriesCost
+	}
+	return cost
+}
+
+func metricCost(m model.Metric) int64 {
+	var cost int64
+	for name, value := range m {
+		cost += labelCost + int64(len(name)+len(value))
+	}
+	return cost
+}
+
+// errUnexpectedValue describes a query result of another type than the query should evaluate to,
+// e.g. because of a misconfigured recording rule.
+func errUnexpectedValue(expected model.ValueType, value model.Value) error {
+	if value == nil {
+		return fmt.Errorf("expected %s, got nothing", expected)
+	}
+	return fmt.Errorf("expected %s, got %s", expected, value.Type())
+}
+
+// errStatusValue returns the error for a status query that didn't evaluate to a vector.
+// Scalars and strings come from misconfigured recording rules, e.g. wrapped in scalar(),
+// which users have to fix, any other type is an internal error.
+// Like an ambiguous expr in getObjective, the misconfiguration is returned as aborted.
+func errStatusValue(query string, value model.Value) error {
+	err := fmt.Errorf("%s: %w", query, errUnexpectedValue(model.ValVector, value))
+	switch value.(type) {
+	case *model.Scalar, *model.String:
+		return connect.NewError(connect.CodeAborted, fmt.Errorf("%w: the recording rule must produce an instant vector", err))
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// queryErrorCode returns the code for errors returned by Prometheus queries.
+// Timeouts are returned as deadline exceeded and canceled requests as canceled to distinguish them from other failures.
+func queryErrorCode(err error) connect.Code {
+	if errors.Is(err, context.Canceled) {
+		return connect.CodeCanceled
+	}
+	var apiErr *prometheusapiv1.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &apiErr) && apiErr.Type == prometheusapiv1.ErrTimeout) {
+		return connect.CodeDeadlineExceeded
+	}
+	return connect.CodeInternal
+}
+
+// queryWarnings collects the deduplicated warnings of Prometheus queries.
+// It is safe for concurrent use.
+type queryWarnings struct {
+	mu       sync.Mutex
+	warnings []string
+}
+
+func (w *queryWarnings) add(warnings prometheusapiv1.Warnings) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+Warnings:
+	for _, warning := range warnings {
+		for _, existing := range w.warnings {
+			if warning == existing {
+				continue Warnings
+			}
+		}
+		w.warnings = append(w.warnings, warning)
+	}
+}
+
+func (w *queryWarnings) list() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.warnings
+}
+
+// errNoData is returned if Prometheus returns no data for a query, e.g. as recording rules are missing.
+var errNoData = errors.New("no data returned from Prometheus")
+
 type objectiveServer struct {
 	logger  log.Logger
 	promAPI *promCache
 	client  objectivesv1alpha1connect.ObjectiveBackendServiceClient
+
+	maxQueryResolution int
+	minQueryStep       time.Duration
+
+	// maxQueryRange rejects graphs requested with a longer range between start and end, unlimited if 0.
+	maxQueryRange time.Duration
+
+	// rateWindow is the rate window for the shortest time ranges, defaults to 5m if 0.
+	rateWindow time.Duration
+
+	// errorBudgetRange caps the range of error budget graphs without start and end, which defaults to the objective's window.
+	errorBudgetRange time.Duration
+
+	// queryOffset shifts the evaluation time of requests without an explicit time or range back from now,
+	// for delayed metrics to have settled.
+	queryOffset time.Duration
+
+	// exposeQueries includes the PromQL query in errors of failed queries returned to clients.
+	exposeQueries bool
+
+	// demo responds with empty graphs instead of errNoData, to render the UI without Pyrra's recording rules.
+	demo bool
+
+	// objectivesMatched observes how many objectives the expr of requests for a single objective matched by procedure.
+	objectivesMatched *prometheus.HistogramVec
+
+	// zeroTraffic is set to the number of objectives without any requests whenever all objectives' statuses are listed.
+	zeroTraffic prometheus.Gauge
+}
+
+const defaultMaxQueryResolution = 1000
+
+// now returns the time statuses and graphs are evaluated at unless requested otherwise, which is now minus the query offset.
+func (s *objectiveServer) now() time.Time {
+	return time.Now().Add(-s.queryOffset)
+}
+
+// queryStep returns the step for range queries between start and end.
+// It returns at most maxQueryResolution points but never steps smaller than minQueryStep.
+func (s *objectiveServer) queryStep(start, end time.Time) time.Duration {
+	resolution := s.maxQueryResolution
+	if resolution <= 0 {
+		resolution = defaultMaxQueryResolution
+	}
+	step := end.Sub(start) / time.Duration(resolution)
+	if step < s.minQueryStep {
+		step = s.minQueryStep
+	}
+	return step
+}
+
+// exposeQuery adds the query to the error of a failed Prometheus query if exposeQueries is enabled.
+// It's off by default to not leak internal metric names to clients.
+func (s *objectiveServer) exposeQuery(query string, err error) error {
+	if !s.exposeQueries {
+		return err
+	}
+	return fmt.Errorf("query %s failed: %w", query, err)
+}
+
+// checkQueryRange returns an InvalidArgument error if the range between start and end is longer than maxQueryRange.
+func (s *objectiveServer) checkQueryRange(start, end time.Time) error {
+	if s.maxQueryRange > 0 && end.Sub(start) > s.maxQueryRange {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(
+			"time range %s is longer than the maximum of %s",
+			model.Duration(end.Sub(start)), model.Duration(s.maxQueryRange),
+		))
+	}
+	return nil
 }
 
-func (s *objectiveServer) getObjective(ctx context.Context, expr string) (slo.Objective, error) {
+// getObjective returns the only objective matching expr.
+// The number of matched objectives is observed by procedure, to see how often exprs match none or more than one.
+func (s *objectiveServer) getObjective(ctx context.Context, procedure, expr string) (slo.Objective, error) {
 	resp, err := s.client.List(ctx, connect.NewRequest(&objectivesv1alpha1.ListRequest{
 		Expr: expr,
 	}))
@@ -633,26 +2046,68 @@ func (s *objectiveServer) getObjective(ctx context.Context, expr string) (slo.Ob
 		return slo.Objective{}, err
 	}
 
+	if s.objectivesMatched != nil {
+		s.objectivesMatched.WithLabelValues(procedure).Observe(float64(len(resp.Msg.Objectives)))
+	}
+
+	if len(resp.Msg.Objectives) == 0 {
+		return slo.Objective{}, noObjectiveError(resp.Header())
+	}
 	if len(resp.Msg.Objectives) != 1 {
-		return slo.Objective{}, connect.NewError(connect.CodeAborted, fmt.Errorf("expr matches more than one SLO, it matches: %d", len(resp.Msg.Objectives)))
+		return slo.Objective{}, ambiguousExprError(resp.Msg.Objectives)
 	}
 
 	return objectivesv1alpha1.ToInternal(resp.Msg.Objectives[0]), nil
 }
 
+// ambiguousExprError is returned if a valid expr isn't specific enough and matches more than one objective.
+// The matched objectives are attached as a ListResponse detail for clients to let users pick one of them.
+func ambiguousExprError(objectives []*objectivesv1alpha1.Objective) error {
+	matches := make([]string, 0, len(objectives))
+	for _, o := range objectives {
+		matches = append(matches, objectivesv1alpha1.ToInternal(o).Labels.String())
+	}
+
+	err := connect.NewError(connect.CodeAborted, fmt.Errorf("expr matches more than one SLO: %s", strings.Join(matches, ", ")))
+	if detail, detailErr := connect.NewErrorDetail(&objectivesv1alpha1.ListResponse{Objectives: objectives}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
 func (s *objectiveServer) List(ctx context.Context, req *connect.Request[objectivesv1alpha1.ListRequest]) (*connect.Response[objectivesv1alpha1.ListResponse], error) {
 	if expr := req.Msg.Expr; expr != "" {
 		if _, err := parser.ParseMetricSelector(expr); err != nil {
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to parse expr: %w", err))
 		}
 	}
+	if req.Msg.SortBy != "" && req.Msg.SortBy != "name" && req.Msg.SortBy != "window" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid sort_by %q: must be either 'name' or 'window'", req.Msg.SortBy))
+	}
+	var window time.Duration
+	if req.Msg.Window != "" {
+		w, err := model.ParseDuration(req.Msg.Window)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid window: %w", err))
+		}
+		window = time.Duration(w)
+	}
+	if req.Msg.TargetMin < 0 || req.Msg.TargetMax < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target_min and target_max must not be negative"))
+	}
+	if req.Msg.TargetMax > 0 && req.Msg.TargetMin > req.Msg.TargetMax {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("target_min %v must not be greater than target_max %v", req.Msg.TargetMin, req.Msg.TargetMax))
+	}
 
-	resp, err := s.client.List(ctx, connect.NewRequest(&objectivesv1alpha1.ListRequest{
+	// The overview lists objectives fresh from the backend to immediately show new or changed objectives.
+	resp, err := s.client.List(contextBypassBackendCache(ctx), connect.NewRequest(&objectivesv1alpha1.ListRequest{
 		Expr: req.Msg.Expr,
 	}))
 	if err != nil {
 		return nil, err
 	}
+	// The response may be shared with the backend cache, the filtered objectives are therefore a new slice.
+	objectives := filterObjectives(resp.Msg.Objectives, window, req.Msg.TargetMin, req.Msg.TargetMax)
 
 	groupingMatchers := map[string]*labels.Matcher{}
 	if req.Msg.Grouping != "" {
@@ -665,7 +2120,7 @@ func (s *objectiveServer) List(ctx context.Context, req *connect.Request[objecti
 		}
 	}
 
-	for _, o := range resp.Msg.Objectives {
+	for _, o := range objectives {
 		oi := objectivesv1alpha1.ToInternal(o)
 
 		// If specific grouping was selected we need to merge the label matchers for the queries.
@@ -758,22 +2213,100 @@ func (s *objectiveServer) List(ctx context.Context, req *connect.Request[objecti
 			}
 		}
 
-		o.Queries = &objectivesv1alpha1.Queries{
-			CountTotal:       oi.QueryTotal(oi.Window),
-			CountErrors:      oi.QueryErrors(oi.Window),
-			GraphErrorBudget: oi.QueryErrorBudget(),
-			GraphRequests:    oi.RequestRange(time.Second),
-			GraphErrors:      oi.ErrorsRange(time.Second),
+		o.Queries = &objectivesv1alpha1.Queries{
+			CountTotal:       oi.QueryTotal(oi.Window),
+			CountErrors:      oi.QueryErrors(oi.Window),
+			GraphErrorBudget: oi.QueryErrorBudget(),
+			GraphRequests:    oi.RequestRange(time.Second),
+			GraphErrors:      oi.ErrorsRange(time.Second),
+		}
+	}
+
+	if req.Msg.Validate {
+		s.validateRules(ctx, objectives)
+	}
+
+	sortObjectives(objectives, req.Msg.SortBy)
+
+	out := connect.NewResponse(&objectivesv1alpha1.ListResponse{
+		Objectives: objectives,
+	})
+	if partialList(resp.Header()) {
+		out.Header().Set(partialListHeader, "true")
+	}
+	return out, nil
+}
+
+// validateRules sets each objective's rules state by checking whether its increase recording rule has any series.
+// Objectives whose check fails stay unvalidated instead of failing the whole list.
+func (s *objectiveServer) validateRules(ctx context.Context, objectives []*objectivesv1alpha1.Objective) {
+	var g errgroup.Group
+	g.SetLimit(listStatusesConcurrency)
+	for _, o := range objectives {
+		g.Go(func() error {
+			objective := objectivesv1alpha1.ToInternal(o)
+			query := fmt.Sprintf("count(%s)", objective.QueryTotal(objective.Window))
+			value, _, err := s.promAPI.Query(contextSetPromCache(ctx, time.Minute), query, s.now())
+			if err != nil {
+				level.Warn(s.logger).Log("msg", "failed to validate recording rules", "objective", objective.Labels.String(), "err", err)
+				return nil
+			}
+			vector, ok := value.(model.Vector)
+			if !ok {
+				level.Warn(s.logger).Log("msg", "failed to validate recording rules", "objective", objective.Labels.String(), "err", errUnexpectedValue(model.ValVector, value))
+				return nil
+			}
+			o.RulesState = objectivesv1alpha1.Objective_missingRules
+			if len(vector) > 0 && vector[0].Value > 0 {
+				o.RulesState = objectivesv1alpha1.Objective_configured
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
+// filterObjectives only keeps the objectives with the given window and a target within [targetMin, targetMax].
+// Zero values don't filter. The given objectives are not modified, the kept ones are returned in a new slice.
+func filterObjectives(objectives []*objectivesv1alpha1.Objective, window time.Duration, targetMin, targetMax float64) []*objectivesv1alpha1.Objective {
+	filtered := make([]*objectivesv1alpha1.Objective, 0, len(objectives))
+	for _, o := range objectives {
+		if window != 0 && o.GetWindow().AsDuration() != window {
+			continue
+		}
+		if targetMin != 0 && o.GetTarget() < targetMin {
+			continue
+		}
+		if targetMax != 0 && o.GetTarget() > targetMax {
+			continue
+		}
+		filtered = append(filtered, o)
+	}
+	return filtered
+}
+
+// sortObjectives sorts the objectives deterministically, as backends may return them in any order.
+// By default, objectives are sorted by their name and then by their full label set.
+// If sortBy is "window" objectives are sorted by their window first.
+func sortObjectives(objectives []*objectivesv1alpha1.Objective, sortBy string) {
+	sort.SliceStable(objectives, func(i, j int) bool {
+		if sortBy == "window" {
+			wi, wj := objectives[i].GetWindow().AsDuration(), objectives[j].GetWindow().AsDuration()
+			if wi != wj {
+				return wi < wj
+			}
+		}
+
+		ni, nj := objectives[i].GetLabels()[labels.MetricName], objectives[j].GetLabels()[labels.MetricName]
+		if ni != nj {
+			return ni < nj
 		}
-	}
-
-	return connect.NewResponse(&objectivesv1alpha1.ListResponse{
-		Objectives: resp.Msg.Objectives,
-	}), nil
+		return labels.FromMap(objectives[i].GetLabels()).String() < labels.FromMap(objectives[j].GetLabels()).String()
+	})
 }
 
 func (s *objectiveServer) GetStatus(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetStatusRequest]) (*connect.Response[objectivesv1alpha1.GetStatusResponse], error) {
-	objective, err := s.getObjective(ctx, req.Msg.Expr)
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
 	if err != nil {
 		return nil, err
 	}
@@ -784,38 +2317,271 @@ func (s *objectiveServer) GetStatus(ctx context.Context, req *connect.Request[ob
 		if err != nil {
 			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
-		if objective.Indicator.Ratio != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Ratio.Errors.LabelMatchers = append(objective.Indicator.Ratio.Errors.LabelMatchers, m)
-				objective.Indicator.Ratio.Total.LabelMatchers = append(objective.Indicator.Ratio.Total.LabelMatchers, m)
-			}
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
 		}
-		if objective.Indicator.Latency != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Latency.Success.LabelMatchers = append(objective.Indicator.Latency.Success.LabelMatchers, m)
-				objective.Indicator.Latency.Total.LabelMatchers = append(objective.Indicator.Latency.Total.LabelMatchers, m)
-			}
+		objective = mergeGrouping(objective, groupingMatchers)
+	}
+
+	ts, err := statusTime(req.Msg.Time, time.Now(), s.queryOffset)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Time == nil {
+		ctx = contextSetPromCacheNow(ctx)
+	}
+	if err := validatePrecision(req.Msg.Precision); err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.objectiveStatus(ctx, objective, ts)
+	if err != nil {
+		return nil, err
+	}
+	roundStatuses(statuses, req.Msg.Precision)
+
+	return connect.NewResponse(&objectivesv1alpha1.GetStatusResponse{
+		Status: statuses,
+	}), nil
+}
+
+// statusTimeSkew is how far in the future a status' evaluation time may be, to allow for clock skew with clients.
+const statusTimeSkew = time.Minute
+
+// statusTime returns the time to evaluate statuses at, now minus the offset unless an explicit time is requested.
+// Explicit times are for looking at past statuses, e.g. after an incident, they must not be in the future.
+func statusTime(t *timestamppb.Timestamp, now time.Time, offset time.Duration) (time.Time, error) {
+	if t == nil {
+		return now.Add(-offset), nil
+	}
+	if err := t.CheckValid(); err != nil {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid time: %w", err))
+	}
+	ts := t.AsTime()
+	if ts.After(now.Add(statusTimeSkew)) {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("time %s is in the future", ts.Format(time.RFC3339)))
+	}
+	return ts, nil
+}
+
+// maxStatusPrecision is the most decimal places statuses can be rounded to, float64 can't represent more reliably.
+const maxStatusPrecision = 15
+
+func validatePrecision(precision uint32) error {
+	if precision > maxStatusPrecision {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid precision %d: must be at most %d", precision, maxStatusPrecision))
+	}
+	return nil
+}
+
+// roundStatuses rounds the availability percentage and remaining error budget to precision decimal places.
+// API consumers can compare the rounded values reliably, a precision of 0 doesn't round at all.
+func roundStatuses(statuses []*objectivesv1alpha1.ObjectiveStatus, precision uint32) {
+	if precision == 0 {
+		return
+	}
+	p := math.Pow10(int(precision))
+	for _, s := range statuses {
+		s.Availability.Percentage = math.Round(s.Availability.Percentage*p) / p
+		s.Budget.Remaining = math.Round(s.Budget.Remaining*p) / p
+	}
+}
+
+// validateGrouping returns an InvalidArgument error if a grouping matcher isn't one of the objective's grouping labels.
+// The recording rules only keep the grouping labels, matching on any other label would silently return no data.
+func validateGrouping(objective slo.Objective, matchers []*labels.Matcher) error {
+	grouping := objective.Grouping()
+	for _, m := range matchers {
+		if slices.Contains(grouping, m.Name) {
+			continue
 		}
-		if objective.Indicator.BoolGauge != nil {
-			objective.Indicator.BoolGauge.LabelMatchers = append(objective.Indicator.BoolGauge.LabelMatchers, groupingMatchers...)
+		if len(grouping) == 0 {
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid grouping label %q: objective has no grouping labels", m.Name))
 		}
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid grouping label %q: valid grouping labels are %s", m.Name, strings.Join(grouping, ", ")))
+	}
+	return nil
+}
+
+// mergeGrouping adds the grouping matchers to the objective's selectors to only query the matching groups.
+// All matcher types are supported: =, !=, =~ and !~. Matchers like {job=~"api-.*"} can match multiple groups,
+// which are still aggregated by the grouping labels and returned as individual series.
+// The errors and total selectors get the same matchers, for errors and totals of each group to match.
+func mergeGrouping(objective slo.Objective, matchers []*labels.Matcher) slo.Objective {
+	merge := func(metric *slo.Metric) {
+		// Clone the matchers to never append to a slice shared with another selector.
+		metric.LabelMatchers = append(slices.Clone(metric.LabelMatchers), matchers...)
 	}
 
-	ts := time.Now()
-	if req.Msg.Time != nil {
-		ts = req.Msg.Time.AsTime()
+	if objective.Indicator.Ratio != nil {
+		merge(&objective.Indicator.Ratio.Errors)
+		merge(&objective.Indicator.Ratio.Total)
+	}
+	if objective.Indicator.Latency != nil {
+		merge(&objective.Indicator.Latency.Success)
+		merge(&objective.Indicator.Latency.Total)
+	}
+	if objective.Indicator.LatencyNative != nil {
+		merge(&objective.Indicator.LatencyNative.Total)
 	}
+	if objective.Indicator.BoolGauge != nil {
+		merge(&objective.Indicator.BoolGauge.Metric)
+	}
+	return objective
+}
 
-	queryTotal := objective.QueryTotal(objective.Window)
-	value, _, err := s.promAPI.Query(contextSetPromCache(ctx, 15*time.Second), queryTotal, ts)
+// requestAggregations are the aggregations GraphRate supports for its aggregate parameter.
+var requestAggregations = map[string]parser.ItemType{
+	"sum": parser.SUM,
+	"avg": parser.AVG,
+}
+
+// aggregateQuery wraps the query in the aggregation by the grouping labels,
+// e.g. sum by (job) (...), returning a single series per group.
+func aggregateQuery(query, aggregate string, grouping []string) (string, error) {
+	op, ok := requestAggregations[aggregate]
+	if !ok {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid aggregate %q: must be either 'sum' or 'avg'", aggregate))
+	}
+
+	expr, err := parser.ParseExpr(query)
 	if err != nil {
-		level.Warn(s.logger).Log("msg", "failed to query total", "query", queryTotal, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return "", connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse query: %w", err))
+	}
+
+	return (&parser.AggregateExpr{
+		Op:       op,
+		Expr:     expr,
+		Grouping: grouping,
+	}).String(), nil
+}
+
+// listStatusesConcurrency limits how many objectives ListObjectiveStatuses queries concurrently.
+const listStatusesConcurrency = 8
+
+func (s *objectiveServer) ListObjectiveStatuses(ctx context.Context, req *connect.Request[objectivesv1alpha1.ListObjectiveStatusesRequest]) (*connect.Response[objectivesv1alpha1.ListObjectiveStatusesResponse], error) {
+	if expr := req.Msg.Expr; expr != "" {
+		if _, err := parser.ParseMetricSelector(expr); err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to parse expr: %w", err))
+		}
+	}
+
+	resp, err := s.client.List(ctx, connect.NewRequest(&objectivesv1alpha1.ListRequest{
+		Expr: req.Msg.Expr,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	ts, err := statusTime(req.Msg.Time, time.Now(), s.queryOffset)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Time == nil {
+		ctx = contextSetPromCacheNow(ctx)
+	}
+	if err := validatePrecision(req.Msg.Precision); err != nil {
+		return nil, err
+	}
+
+	// Each objective's status is queried independently,
+	// a failing objective only sets its own error instead of failing the whole response.
+	objectives := make([]*objectivesv1alpha1.ObjectiveStatuses, len(resp.Msg.Objectives))
+	var g errgroup.Group
+	g.SetLimit(listStatusesConcurrency)
+	for i, o := range resp.Msg.Objectives {
+		objectives[i] = &objectivesv1alpha1.ObjectiveStatuses{Labels: o.Labels}
+		g.Go(func() error {
+			statuses, err := s.objectiveStatus(ctx, objectivesv1alpha1.ToInternal(o), ts)
+			if err != nil {
+				objectives[i].Error = err.Error()
+				return nil
+			}
+			roundStatuses(statuses, req.Msg.Precision)
+			objectives[i].Status = statuses
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, connect.NewError(queryErrorCode(err), err)
+	}
+
+	// Objectives without any requests have no statuses, as their groups without traffic are skipped.
+	// They look healthy in the UI, while their metrics might not be collected at all.
+	// Filtered lists and statuses of the past would make the gauge jump, only the current statuses of all objectives are counted.
+	// Lists missing the objectives of failed backends would do so too.
+	partial := partialList(resp.Header())
+	if s.zeroTraffic != nil && req.Msg.Expr == "" && req.Msg.Time == nil && !partial {
+		var zeroTraffic int
+		for _, o := range objectives {
+			if o.Error == "" && len(o.Status) == 0 {
+				zeroTraffic++
+			}
+		}
+		s.zeroTraffic.Set(float64(zeroTraffic))
+	}
+
+	out := connect.NewResponse(&objectivesv1alpha1.ListObjectiveStatusesResponse{
+		Objectives: objectives,
+	})
+	if partial {
+		out.Header().Set(partialListHeader, "true")
+	}
+	return out, nil
+}
+
+// objectiveStatus queries the objective's availability and error budget at ts, one status for each group.
+func (s *objectiveServer) objectiveStatus(ctx context.Context, objective slo.Objective, ts time.Time) ([]*objectivesv1alpha1.ObjectiveStatus, error) {
+	queryTotal := objective.QueryTotal(objective.Window)
+	queryErrors := objective.QueryErrors(objective.Window)
+
+	// Query total and errors concurrently, the results are merged once both returned.
+	var (
+		totalValue, errorsValue model.Value
+		warnings                queryWarnings
+	)
+	// The availability over long windows changes slowly, their statuses are cached for longer.
+	cacheDuration := instantCache(time.Duration(objective.Window))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		value, w, err := s.promAPI.Query(contextSetPromCache(gctx, cacheDuration), queryTotal, ts)
+		if err != nil {
+			level.Warn(s.logger).Log("msg", "failed to query total", "query", queryTotal, "err", err)
+			return s.exposeQuery(queryTotal, err)
+		}
+		warnings.add(w)
+		totalValue = value
+		return nil
+	})
+	g.Go(func() error {
+		value, w, err := s.promAPI.Query(contextSetPromCache(gctx, cacheDuration), queryErrors, ts)
+		if err != nil {
+			level.Warn(s.logger).Log("msg", "failed to query errors", "query", queryErrors, "err", err)
+			return s.exposeQuery(queryErrors, err)
+		}
+		warnings.add(w)
+		errorsValue = value
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(queryErrorCode(err), err)
+	}
+
+	totalVector, ok := totalValue.(model.Vector)
+	if !ok {
+		return nil, errStatusValue("total", totalValue)
+	}
+	errorsVector, ok := errorsValue.(model.Vector)
+	if !ok {
+		return nil, errStatusValue("errors", errorsValue)
 	}
 
 	statuses := map[model.Fingerprint]*objectivesv1alpha1.ObjectiveStatus{}
 
-	for _, v := range value.(model.Vector) {
+	for _, v := range totalVector {
 		ls := make(map[string]string)
 		for k, v := range v.Metric {
 			ls[string(k)] = string(v)
@@ -830,13 +2596,7 @@ func (s *objectiveServer) GetStatus(ctx context.Context, req *connect.Request[ob
 		}
 	}
 
-	queryErrors := objective.QueryErrors(objective.Window)
-	value, _, err = s.promAPI.Query(contextSetPromCache(ctx, 15*time.Second), queryErrors, ts)
-	if err != nil {
-		level.Warn(s.logger).Log("msg", "failed to query errors", "query", queryErrors, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-	for _, v := range value.(model.Vector) {
+	for _, v := range errorsVector {
 		if s, exists := statuses[v.Metric.Fingerprint()]; exists {
 			s.Availability.Errors = float64(v.Value)
 			s.Availability.Percentage = 1 - (s.Availability.Errors / s.Availability.Total)
@@ -846,11 +2606,11 @@ func (s *objectiveServer) GetStatus(ctx context.Context, req *connect.Request[ob
 				objectiveLabels[string(k)] = string(v)
 			}
 
+			// Errors without any total requests, the status is skipped below.
 			statuses[v.Metric.Fingerprint()] = &objectivesv1alpha1.ObjectiveStatus{
 				Labels: objectiveLabels,
 				Availability: &objectivesv1alpha1.Availability{
-					Percentage: 1 - (s.Availability.Errors / s.Availability.Total),
-					Total:      float64(v.Value),
+					Errors: float64(v.Value),
 				},
 			}
 		}
@@ -858,10 +2618,12 @@ func (s *objectiveServer) GetStatus(ctx context.Context, req *connect.Request[ob
 
 	statusSlice := make([]*objectivesv1alpha1.ObjectiveStatus, 0, len(statuses))
 	for _, s := range statuses {
+		s.Warnings = warnings.list()
 		s.Budget = &objectivesv1alpha1.Budget{}
 		s.Budget.Total = 1 - objective.Target
 		s.Budget.Remaining = (s.Budget.Total - (s.Availability.Errors / s.Availability.Total)) / s.Budget.Total
 		s.Budget.Max = s.Budget.Total * s.Availability.Total
+		s.Budget.Allowed = durationpb.New(objective.ErrorBudget())
 
 		// If this objective has no requests, we'll skip showing it too
 		if s.Availability.Total == 0 {
@@ -878,107 +2640,291 @@ func (s *objectiveServer) GetStatus(ctx context.Context, req *connect.Request[ob
 		statusSlice = append(statusSlice, s)
 	}
 
-	return connect.NewResponse(&objectivesv1alpha1.GetStatusResponse{
-		Status: statusSlice,
-	}), nil
+	return statusSlice, nil
 }
 
-func (s *objectiveServer) GraphErrorBudget(ctx context.Context, req *connect.Request[objectivesv1alpha1.GraphErrorBudgetRequest]) (*connect.Response[objectivesv1alpha1.GraphErrorBudgetResponse], error) {
-	objective, err := s.getObjective(ctx, req.Msg.Expr)
+// GetBudgetExhaustion projects when the remaining error budget of each group is exhausted at the current burn rate.
+// The remaining error budget is the same as the status', groups without any traffic are skipped.
+func (s *objectiveServer) GetBudgetExhaustion(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetBudgetExhaustionRequest]) (*connect.Response[objectivesv1alpha1.GetBudgetExhaustionResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
 	if err != nil {
 		return nil, err
 	}
 
-	if req.Msg.Grouping != "" && req.Msg.Grouping != "{}" {
+	if req.Msg.Grouping != "" {
 		groupingMatchers, err := parser.ParseMetricSelector(req.Msg.Grouping)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing alerts metric: %w", err))
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
 		}
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
+		}
+		objective = mergeGrouping(objective, groupingMatchers)
+	}
 
-		if ratio := objective.Indicator.Ratio; ratio != nil {
-			groupings := map[string]struct{}{}
-			for _, g := range ratio.Grouping {
-				groupings[g] = struct{}{}
-			}
-			for _, m := range groupingMatchers {
-				objective.Indicator.Ratio.Errors.LabelMatchers = append(objective.Indicator.Ratio.Errors.LabelMatchers, m)
-				objective.Indicator.Ratio.Total.LabelMatchers = append(objective.Indicator.Ratio.Total.LabelMatchers, m)
-				delete(groupings, m.Name)
-			}
+	ts := s.now()
+	statuses, err := s.objectiveStatus(ctx, objective, ts)
+	if err != nil {
+		return nil, err
+	}
 
-			objective.Indicator.Ratio.Grouping = []string{}
-			for g := range groupings {
-				objective.Indicator.Ratio.Grouping = append(objective.Indicator.Ratio.Grouping, g)
-			}
+	// The shortest long window of the alerts is recorded and still smooths out short spikes.
+	window := objective.Windows()[0].Long
+	query, err := objective.QueryBurnrate(window, nil)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to prepare burn rate query: %w", err))
+	}
+	value, _, err := s.promAPI.Query(contextSetPromCache(ctx, instantCache(window)), query, ts)
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to query current burn rate", "query", query, "err", err)
+		return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
+	}
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errUnexpectedValue(model.ValVector, value))
+	}
+
+	// The burn rate series have more labels than the statuses, match them by the grouping labels only.
+	grouping := objective.Grouping()
+	burnrates := make(map[model.Fingerprint]float64, len(vector))
+	for _, sample := range vector {
+		burnrates[groupingFingerprint(sample.Metric, grouping)] = float64(sample.Value)
+	}
+
+	exhaustion := make([]*objectivesv1alpha1.BudgetExhaustion, 0, len(statuses))
+	for _, status := range statuses {
+		metric := make(model.Metric, len(status.Labels))
+		for name, value := range status.Labels {
+			metric[model.LabelName(name)] = model.LabelValue(value)
 		}
-		if objective.Indicator.Latency != nil {
-			groupings := map[string]struct{}{}
-			for _, g := range objective.Indicator.Ratio.Grouping {
-				groupings[g] = struct{}{}
-			}
 
-			for _, m := range groupingMatchers {
-				objective.Indicator.Latency.Success.LabelMatchers = append(objective.Indicator.Latency.Success.LabelMatchers, m)
-				objective.Indicator.Latency.Total.LabelMatchers = append(objective.Indicator.Latency.Total.LabelMatchers, m)
-				delete(groupings, m.Name)
+		e := &objectivesv1alpha1.BudgetExhaustion{
+			Labels:    status.Labels,
+			Remaining: status.Budget.Remaining,
+			Burnrate: &objectivesv1alpha1.Burnrate{
+				Window: durationpb.New(window),
+				Query:  query,
+			},
+		}
+		current, found := burnrates[groupingFingerprint(metric, grouping)]
+		if found && !math.IsNaN(current) {
+			e.Burnrate.Current = proto.Float64(current)
+			if exhausted, burning := budgetExhausted(objective, ts, status.Budget.Remaining, current); burning {
+				e.Exhausted = timestamppb.New(exhausted)
 			}
+		}
+		exhaustion = append(exhaustion, e)
+	}
 
-			objective.Indicator.Latency.Grouping = []string{}
-			for g := range groupings {
-				objective.Indicator.Latency.Grouping = append(objective.Indicator.Latency.Grouping, g)
-			}
+	return connect.NewResponse(&objectivesv1alpha1.GetBudgetExhaustionResponse{
+		Exhaustion: exhaustion,
+	}), nil
+}
+
+// groupingFingerprint returns the fingerprint of only the grouping labels of the metric.
+func groupingFingerprint(metric model.Metric, grouping []string) model.Fingerprint {
+	ls := make(model.LabelSet, len(grouping))
+	for _, name := range grouping {
+		if value, ok := metric[model.LabelName(name)]; ok {
+			ls[model.LabelName(name)] = value
 		}
-		if objective.Indicator.BoolGauge != nil {
-			groupings := map[string]struct{}{}
-			for _, g := range objective.Indicator.BoolGauge.Grouping {
-				groupings[g] = struct{}{}
-			}
+	}
+	return ls.Fingerprint()
+}
 
-			for _, m := range groupingMatchers {
-				objective.Indicator.BoolGauge.LabelMatchers = append(objective.Indicator.BoolGauge.LabelMatchers, m)
-				delete(groupings, m.Name)
-			}
+// budgetExhausted returns when the remaining error budget is exhausted burning at the burn rate, which is an error ratio.
+// At a burn rate of 1-target the entire error budget lasts exactly the objective's window.
+// The error budget isn't burning if the burn rate isn't positive or so small it would last for centuries.
+func budgetExhausted(objective slo.Objective, ts time.Time, remaining, burnrate float64) (time.Time, bool) {
+	if !(burnrate > 0) {
+		return time.Time{}, false
+	}
+	if !(remaining > 0) {
+		return ts, true
+	}
 
-			objective.Indicator.BoolGauge.Grouping = []string{}
-			for g := range groupings {
-				objective.Indicator.BoolGauge.Grouping = append(objective.Indicator.BoolGauge.Grouping, g)
-			}
+	d := remaining * float64(objective.Window) * (1 - objective.Target) / burnrate
+	if d >= math.MaxInt64 {
+		return time.Time{}, false
+	}
+	return ts.Add(time.Duration(d)), true
+}
+
+// GetGroupingValues returns the distinct values of one of the objective's grouping labels,
+// e.g. for the UI to offer all jobs to select instead of guessing them.
+func (s *objectiveServer) GetGroupingValues(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetGroupingValuesRequest]) (*connect.Response[objectivesv1alpha1.GetGroupingValuesResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Label == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("label must not be empty"))
+	}
+	if err := validateGrouping(objective, []*labels.Matcher{{Name: req.Msg.Label}}); err != nil {
+		return nil, err
+	}
+
+	// New groups show up rarely, the values are cached for a minute.
+	query := objective.QueryGroupingValues(req.Msg.Label)
+	value, _, err := s.promAPI.Query(contextSetPromCache(ctx, time.Minute), query, s.now())
+	if err != nil {
+		return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
+	}
+	vector, ok := value.(model.Vector)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, errUnexpectedValue(model.ValVector, value))
+	}
+
+	values := make([]string, 0, len(vector))
+	for _, sample := range vector {
+		// Series without the label are counted with an empty value, which isn't a group to select.
+		if v := sample.Metric[model.LabelName(req.Msg.Label)]; v != "" {
+			values = append(values, string(v))
+		}
+	}
+	sort.Strings(values)
+
+	return connect.NewResponse(&objectivesv1alpha1.GetGroupingValuesResponse{
+		Values: values,
+	}), nil
+}
+
+// GetSnapshot bundles the objective's status, error budget over the default range and its alerts with current burn rates,
+// e.g. to attach the objective's state to an incident ticket with a single request.
+// The parts are queried concurrently, any failing part fails the snapshot as it would be incomplete otherwise.
+func (s *objectiveServer) GetSnapshot(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetSnapshotRequest]) (*connect.Response[objectivesv1alpha1.GetSnapshotResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := s.now()
+	var (
+		statuses    []*objectivesv1alpha1.ObjectiveStatus
+		errorBudget *objectivesv1alpha1.Timeseries
+		alerts      []*objectivesv1alpha1.Alert
+		warnings    []string
+	)
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		// Like GetStatus without a time, the status is evaluated at now and shares its cached results.
+		statuses, err = s.objectiveStatus(contextSetPromCacheNow(gctx), objective, ts)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		errorBudget, err = s.errorBudgetTimeseries(gctx, objective.QueryErrorBudget(), ts.Add(-s.defaultErrorBudgetRange(objective)), ts)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		alerts, warnings, err = s.alerts(gctx, []slo.Objective{objective}, "", true, true, ts)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&objectivesv1alpha1.GetSnapshotResponse{
+		Objective:   objectivesv1alpha1.FromInternal(objective),
+		Time:        timestamppb.New(ts),
+		Status:      statuses,
+		ErrorBudget: errorBudget,
+		Alerts:      alerts,
+		Warnings:    warnings,
+	}), nil
+}
+
+// defaultErrorBudgetRange returns the range of error budget graphs requested without start and end.
+// It's the objective's window for a meaningful first view, capped by errorBudgetRange if set.
+func (s *objectiveServer) defaultErrorBudgetRange(objective slo.Objective) time.Duration {
+	r := time.Duration(objective.Window)
+	if s.errorBudgetRange > 0 && s.errorBudgetRange < r {
+		r = s.errorBudgetRange
+	}
+	return r
+}
+
+func (s *objectiveServer) GraphErrorBudget(ctx context.Context, req *connect.Request[objectivesv1alpha1.GraphErrorBudgetRequest]) (*connect.Response[objectivesv1alpha1.GraphErrorBudgetResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Grouping != "" && req.Msg.Grouping != "{}" {
+		groupingMatchers, err := parser.ParseMetricSelector(req.Msg.Grouping)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing alerts metric: %w", err))
 		}
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
+		}
+
+		objective = errorBudgetGrouping(objective, groupingMatchers)
 	}
 	if objective.Indicator.LatencyNative != nil && objective.Indicator.Ratio.Total.Name != "" {
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unimplemented"))
 	}
 
-	end := time.Now()
-	start := end.Add(-1 * time.Hour)
+	end := s.now()
+	start := end.Add(-s.defaultErrorBudgetRange(objective))
 
-	if !req.Msg.Start.AsTime().IsZero() && !req.Msg.End.AsTime().IsZero() {
+	if req.Msg.Start != nil && req.Msg.End != nil {
 		start = req.Msg.Start.AsTime()
 		end = req.Msg.End.AsTime()
+		if err := s.checkQueryRange(start, end); err != nil {
+			return nil, err
+		}
 	}
-	step := end.Sub(start) / 1000
 
 	query := objective.QueryErrorBudget()
-	value, _, err := s.promAPI.QueryRange(contextSetPromCache(ctx, 15*time.Second), query, prometheusapiv1.Range{
+	if req.Msg.Window != "" {
+		window, err := model.ParseDuration(req.Msg.Window)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to parse window: %w", err))
+		}
+		if window <= 0 || window > objective.Window {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("window %s must be positive and not longer than the objective's window %s", window, objective.Window))
+		}
+		query = objective.QueryErrorBudgetWindow(window)
+	}
+
+	timeseries, err := s.errorBudgetTimeseries(ctx, query, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&objectivesv1alpha1.GraphErrorBudgetResponse{
+		Timeseries: timeseries,
+	}), nil
+}
+
+// errorBudgetTimeseries queries the error budget query from start to end.
+func (s *objectiveServer) errorBudgetTimeseries(ctx context.Context, query string, start, end time.Time) (*objectivesv1alpha1.Timeseries, error) {
+	step := s.queryStep(start, end)
+	value, warnings, err := s.promAPI.QueryRange(contextSetPromCache(ctx, 15*time.Second), query, prometheusapiv1.Range{
 		Start: start,
 		End:   end,
 		Step:  step,
 	})
 	if err != nil {
 		level.Warn(s.logger).Log("msg", "failed to query error budget", "query", query, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
 	}
 
 	matrix, ok := value.(model.Matrix)
 	if !ok {
-		err := fmt.Errorf("no matrix returned")
-		level.Debug(s.logger).Log("msg", "returned data wasn't of type matrix", "query", query, "err", err)
+		err := errUnexpectedValue(model.ValMatrix, value)
+		level.Warn(s.logger).Log("msg", "returned data wasn't of type matrix", "query", query, "err", err)
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	if len(matrix) == 0 {
 		level.Debug(s.logger).Log("msg", "returned no data", "query", query)
-		return nil, connect.NewError(connect.CodeNotFound, nil)
+		if !s.demo {
+			return nil, connect.NewError(connect.CodeNotFound, errNoData)
+		}
 	}
 
 	valueLength := 0
@@ -988,20 +2934,76 @@ func (s *objectiveServer) GraphErrorBudget(ctx context.Context, req *connect.Req
 		}
 	}
 
-	values := matrixToValues(matrix)
+	values := matrixToValues(matrix)
+
+	// TODO: Return Samples from above function
+	series := make([]*objectivesv1alpha1.Series, 0, len(values))
+	for _, float64s := range values {
+		series = append(series, &objectivesv1alpha1.Series{Values: float64s})
+	}
+
+	return &objectivesv1alpha1.Timeseries{
+		Query:    query,
+		Series:   series,
+		Step:     durationpb.New(step),
+		Warnings: warnings,
+	}, nil
+}
+
+// errorBudgetGrouping merges the grouping matchers into the objective's queries
+// and removes the matched labels from the objective's grouping.
+func errorBudgetGrouping(objective slo.Objective, groupingMatchers []*labels.Matcher) slo.Objective {
+	if ratio := objective.Indicator.Ratio; ratio != nil {
+		groupings := map[string]struct{}{}
+		for _, g := range ratio.Grouping {
+			groupings[g] = struct{}{}
+		}
+		for _, m := range groupingMatchers {
+			objective.Indicator.Ratio.Errors.LabelMatchers = append(objective.Indicator.Ratio.Errors.LabelMatchers, m)
+			objective.Indicator.Ratio.Total.LabelMatchers = append(objective.Indicator.Ratio.Total.LabelMatchers, m)
+			delete(groupings, m.Name)
+		}
+
+		objective.Indicator.Ratio.Grouping = []string{}
+		for g := range groupings {
+			objective.Indicator.Ratio.Grouping = append(objective.Indicator.Ratio.Grouping, g)
+		}
+	}
+	if objective.Indicator.Latency != nil {
+		groupings := map[string]struct{}{}
+		for _, g := range objective.Indicator.Latency.Grouping {
+			groupings[g] = struct{}{}
+		}
+
+		for _, m := range groupingMatchers {
+			objective.Indicator.Latency.Success.LabelMatchers = append(objective.Indicator.Latency.Success.LabelMatchers, m)
+			objective.Indicator.Latency.Total.LabelMatchers = append(objective.Indicator.Latency.Total.LabelMatchers, m)
+			delete(groupings, m.Name)
+		}
+
+		objective.Indicator.Latency.Grouping = []string{}
+		for g := range groupings {
+			objective.Indicator.Latency.Grouping = append(objective.Indicator.Latency.Grouping, g)
+		}
+	}
+	if objective.Indicator.BoolGauge != nil {
+		groupings := map[string]struct{}{}
+		for _, g := range objective.Indicator.BoolGauge.Grouping {
+			groupings[g] = struct{}{}
+		}
+
+		for _, m := range groupingMatchers {
+			objective.Indicator.BoolGauge.LabelMatchers = append(objective.Indicator.BoolGauge.LabelMatchers, m)
+			delete(groupings, m.Name)
+		}
 
-	// TODO: Return Samples from above function
-	series := make([]*objectivesv1alpha1.Series, 0, len(values))
-	for _, float64s := range values {
-		series = append(series, &objectivesv1alpha1.Series{Values: float64s})
+		objective.Indicator.BoolGauge.Grouping = []string{}
+		for g := range groupings {
+			objective.Indicator.BoolGauge.Grouping = append(objective.Indicator.BoolGauge.Grouping, g)
+		}
 	}
 
-	return connect.NewResponse(&objectivesv1alpha1.GraphErrorBudgetResponse{
-		Timeseries: &objectivesv1alpha1.Timeseries{
-			Query:  query,
-			Series: series,
-		},
-	}), nil
+	return objective
 }
 
 func (s *objectiveServer) GetAlerts(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetAlertsRequest]) (*connect.Response[objectivesv1alpha1.GetAlertsResponse], error) {
@@ -1017,21 +3019,39 @@ func (s *objectiveServer) GetAlerts(ctx context.Context, req *connect.Request[ob
 		objectives = append(objectives, objectivesv1alpha1.ToInternal(o))
 	}
 
+	alerts, warnings, err := s.alerts(ctx, objectives, req.Msg.Grouping, req.Msg.Inactive, req.Msg.Current, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&objectivesv1alpha1.GetAlertsResponse{
+		Alerts:   alerts,
+		Warnings: warnings,
+	}), nil
+}
+
+// alerts returns the multi burn rate alerts of the objectives matched with the ALERTS metric and Prometheus' warnings.
+// Inactive alerts are included if requested, and current burn rates are queried for all alerts' windows if requested.
+// All queries are evaluated at ts.
+func (s *objectiveServer) alerts(ctx context.Context, objectives []slo.Objective, grouping string, inactive, current bool, ts time.Time) ([]*objectivesv1alpha1.Alert, []string, error) {
 	// Match alerts that at least have one character for the slo name.
 	queryAlerts := `ALERTS{slo=~".+"}`
+	if len(objectives) == 1 {
+		// Only query the alerts of a single objective, they are matched to the windows in memory.
+		queryAlerts = fmt.Sprintf("ALERTS{%s}", labels.MustNewMatcher(labels.MatchEqual, "slo", objectives[0].Name()))
+	}
 
 	var groupingMatchers []*labels.Matcher
 
-	if req.Msg.Grouping != "" && req.Msg.Grouping != "{}" {
+	if grouping != "" && grouping != "{}" {
 		expr, err := parser.ParseExpr(queryAlerts)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing alerts metric: %w", err))
+			return nil, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing alerts metric: %w", err))
 		}
 
 		// If grouping exists we merge those matchers directly into the queryAlerts query.
-		groupingMatchers, err = parser.ParseMetricSelector(req.Msg.Grouping)
+		groupingMatchers, err = parser.ParseMetricSelector(grouping)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing grouping matchers: %w", err))
+			return nil, nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing grouping matchers: %w", err))
 		}
 
 		vec := expr.(*parser.VectorSelector)
@@ -1045,32 +3065,48 @@ func (s *objectiveServer) GetAlerts(ctx context.Context, req *connect.Request[ob
 		queryAlerts = vec.String()
 	}
 
-	value, _, err := s.promAPI.Query(contextSetPromCache(ctx, 5*time.Second), queryAlerts, time.Now())
+	var warnings queryWarnings
+	value, w, err := s.promAPI.Query(contextSetPromCache(ctx, 5*time.Second), queryAlerts, ts)
 	if err != nil {
 		level.Warn(s.logger).Log("msg", "failed to query alerts", "query", queryAlerts, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, nil, connect.NewError(queryErrorCode(err), s.exposeQuery(queryAlerts, err))
 	}
+	warnings.add(w)
 
 	vector, ok := value.(model.Vector)
 	if !ok {
-		err := fmt.Errorf("no vector returned")
-		level.Debug(s.logger).Log("msg", "returned data wasn't of type vector", "query", queryAlerts, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		err := errUnexpectedValue(model.ValVector, value)
+		level.Warn(s.logger).Log("msg", "returned data wasn't of type vector", "query", queryAlerts, "err", err)
+		return nil, nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	alerts := alertsMatchingObjectives(vector, objectives, groupingMatchers, req.Msg.Inactive)
+	alerts := alertsMatchingObjectives(vector, objectives, groupingMatchers, inactive)
 
-	if req.Msg.Current {
+	if current {
 		for _, objective := range objectives {
 			mtx := &sync.Mutex{}
-			windowsMap := map[time.Duration]float64{}
-			for _, w := range objective.Windows() {
-				windowsMap[w.Short] = -1
-				windowsMap[w.Long] = -1
+			// windowsMap has the current burn rate of each window, nil if unknown.
+			windows := burnrateWindows(objective)
+			windowsMap := make(map[time.Duration]*float64, len(windows))
+			for _, w := range windows {
+				windowsMap[w] = nil
+			}
+			// windowErrors has the errors of failed queries, for clients to tell them apart from missing data.
+			// The errors of Prometheus are only logged unless queries are exposed, they might reveal details about its setup.
+			windowErrors := map[time.Duration]string{}
+			setError := func(w time.Duration, err error) {
+				msg := "failed to query the current burn rate"
+				if s.exposeQueries {
+					msg = err.Error()
+				}
+				mtx.Lock()
+				windowErrors[w] = msg
+				mtx.Unlock()
 			}
 
+			// The goroutines write to windowsMap, therefore they're started from the windows slice.
 			var wg sync.WaitGroup
-			for w := range windowsMap {
+			for _, w := range windows {
 				wg.Add(1)
 				go func(w time.Duration) {
 					defer wg.Done()
@@ -1078,16 +3114,21 @@ func (s *objectiveServer) GetAlerts(ctx context.Context, req *connect.Request[ob
 					query, err := objective.QueryBurnrate(w, groupingMatchers)
 					if err != nil {
 						level.Warn(s.logger).Log("msg", "failed to prepare current burn rate query", "err", err)
+						setError(w, err)
 						return
 					}
-					value, _, err := s.promAPI.Query(contextSetPromCache(ctx, instantCache(w)), query, time.Now())
+					value, ws, err := s.promAPI.Query(contextSetPromCache(ctx, instantCache(w)), query, ts)
 					if err != nil {
 						level.Warn(s.logger).Log("msg", "failed to query current burn rate", "query", query, "err", err)
+						setError(w, s.exposeQuery(query, err))
 						return
 					}
+					warnings.add(ws)
 					vec, ok := value.(model.Vector)
 					if !ok {
-						level.Warn(s.logger).Log("msg", "failed to query current burn rate", "query", query, "err", "expected vector value from Prometheus")
+						err := errUnexpectedValue(model.ValVector, value)
+						level.Warn(s.logger).Log("msg", "failed to query current burn rate", "query", query, "err", err)
+						setError(w, err)
 						return
 					}
 					if vec.Len() == 0 {
@@ -1095,23 +3136,29 @@ func (s *objectiveServer) GetAlerts(ctx context.Context, req *connect.Request[ob
 					}
 					if vec.Len() != 1 {
 						level.Warn(s.logger).Log("msg", "failed to query current burn rate", "query", query, "err", "expected vector with one value from Prometheus")
+						setError(w, fmt.Errorf("expected vector with one value from Prometheus, got %d", vec.Len()))
 						return
 					}
 
 					current := float64(vec[0].Value)
 					if math.IsNaN(current) {
-						// ignore current values if NaN and return the -1 indicating NaN
+						// Leave NaN current values unset like windows without data.
 						return
 					}
 
 					mtx.Lock()
-					windowsMap[w] = current
+					windowsMap[w] = proto.Float64(current)
 					mtx.Unlock()
 				}(w)
 			}
 
 			wg.Wait()
 
+			// The queries of a canceled request failed, don't query the remaining objectives' burn rates.
+			if err := ctx.Err(); err != nil {
+				return nil, nil, connect.NewError(queryErrorCode(err), err)
+			}
+
 			// Match objectives to alerts to update response
 		Alerts:
 			for i, alert := range alerts {
@@ -1123,14 +3170,263 @@ func (s *objectiveServer) GetAlerts(ctx context.Context, req *connect.Request[ob
 				short := alert.Short.Window
 				alerts[i].Short.Window = short
 				alerts[i].Short.Current = windowsMap[short.AsDuration()]
+				alerts[i].Short.Error = windowErrors[short.AsDuration()]
 				long := alert.Long.Window
 				alerts[i].Long.Window = long
 				alerts[i].Long.Current = windowsMap[long.AsDuration()]
+				alerts[i].Long.Error = windowErrors[long.AsDuration()]
+				// Like the generated alerting rule, both windows have to exceed the threshold.
+				// Unknown current burn rates never exceed it.
+				alerts[i].Exceeding = exceedsThreshold(alerts[i].Short, alerts[i].Threshold) && exceedsThreshold(alerts[i].Long, alerts[i].Threshold)
+			}
+		}
+	}
+
+	return alerts, warnings.list(), nil
+}
+
+// exceedsThreshold returns true if the burn rate's current value is known and exceeds the threshold.
+func exceedsThreshold(b *objectivesv1alpha1.Burnrate, threshold float64) bool {
+	return b.Current != nil && *b.Current > threshold
+}
+
+// GetAlertRules returns the multi burn rate alerts generated for an objective.
+// It doesn't query Prometheus, which allows reviewing the alerts of objectives that have no data yet.
+func (s *objectiveServer) GetAlertRules(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetAlertRulesRequest]) (*connect.Response[objectivesv1alpha1.GetAlertRulesResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := objective.Alerts()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate alerts: %w", err))
+	}
+
+	rules := make([]*objectivesv1alpha1.AlertRule, 0, len(alerts))
+	for _, a := range alerts {
+		rules = append(rules, &objectivesv1alpha1.AlertRule{
+			Severity:  a.Severity,
+			For:       durationpb.New(a.For),
+			Factor:    a.Factor,
+			Threshold: a.Factor * (1 - objective.Target),
+			Short: &objectivesv1alpha1.Burnrate{
+				Window: durationpb.New(a.Short),
+				Query:  a.QueryShort,
+			},
+			Long: &objectivesv1alpha1.Burnrate{
+				Window: durationpb.New(a.Long),
+				Query:  a.QueryLong,
+			},
+		})
+	}
+
+	return connect.NewResponse(&objectivesv1alpha1.GetAlertRulesResponse{Rules: rules}), nil
+}
+
+// GetSource returns the original definition of the objective matching the expr.
+func (s *objectiveServer) GetSource(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetSourceRequest]) (*connect.Response[objectivesv1alpha1.GetSourceResponse], error) {
+	if expr := req.Msg.Expr; expr != "" {
+		if _, err := parser.ParseMetricSelector(expr); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("failed to parse expr: %w", err))
+		}
+	}
+
+	resp, err := s.client.List(ctx, connect.NewRequest(&objectivesv1alpha1.ListRequest{
+		Expr: req.Msg.Expr,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.objectivesMatched != nil {
+		s.objectivesMatched.WithLabelValues(req.Spec().Procedure).Observe(float64(len(resp.Msg.Objectives)))
+	}
+
+	switch len(resp.Msg.Objectives) {
+	case 0:
+		return nil, noObjectiveError(resp.Header())
+	case 1:
+		return connect.NewResponse(&objectivesv1alpha1.GetSourceResponse{
+			Source: resp.Msg.Objectives[0].Config,
+		}), nil
+	default:
+		return nil, ambiguousExprError(resp.Msg.Objectives)
+	}
+}
+
+// GetRules returns the recording and alerting rules generated for the objective matching the expr.
+// These are the rules the backends deploy, except the optional generic rules.
+func (s *objectiveServer) GetRules(ctx context.Context, req *connect.Request[objectivesv1alpha1.GetRulesRequest]) (*connect.Response[objectivesv1alpha1.GetRulesResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := objectiveRuleSpec(objective)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*objectivesv1alpha1.RuleGroup, 0, 2)
+	for _, group := range spec.Groups {
+		rules := make([]*objectivesv1alpha1.Rule, 0, len(group.Rules))
+		for _, r := range group.Rules {
+			rule := &objectivesv1alpha1.Rule{
+				Record:      r.Record,
+				Alert:       r.Alert,
+				Expr:        r.Expr.String(),
+				Labels:      r.Labels,
+				Annotations: r.Annotations,
+			}
+			if r.For != nil {
+				rule.For = string(*r.For)
 			}
+			rules = append(rules, rule)
+		}
+
+		g := &objectivesv1alpha1.RuleGroup{Name: group.Name, Rules: rules}
+		if group.Interval != nil {
+			g.Interval = string(*group.Interval)
+		}
+		groups = append(groups, g)
+	}
+
+	return connect.NewResponse(&objectivesv1alpha1.GetRulesResponse{Groups: groups}), nil
+}
+
+// objectiveRuleSpec returns the recording and alerting rules Pyrra generates for the objective.
+func objectiveRuleSpec(objective slo.Objective) (monitoringv1.PrometheusRuleSpec, error) {
+	// The backends don't send the alerting configuration, therefore the rules are generated from the objective's config.
+	if objective.Config != "" {
+		var config v1alpha1.ServiceLevelObjective
+		err := yaml.Unmarshal([]byte(objective.Config), &config)
+		if err != nil {
+			return monitoringv1.PrometheusRuleSpec{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to unmarshal objective config: %w", err))
+		}
+		if objective, err = config.Internal(); err != nil {
+			return monitoringv1.PrometheusRuleSpec{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get objective from config: %w", err))
+		}
+	}
+
+	increases, err := objective.IncreaseRules()
+	if err != nil {
+		return monitoringv1.PrometheusRuleSpec{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate increase rules: %w", err))
+	}
+	burnrates, err := objective.Burnrates()
+	if err != nil {
+		return monitoringv1.PrometheusRuleSpec{}, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to generate burn rate rules: %w", err))
+	}
+
+	return monitoringv1.PrometheusRuleSpec{
+		Groups: []monitoringv1.RuleGroup{increases, burnrates},
+	}, nil
+}
+
+// rulesFileHandler returns the generated rules of a single objective as a Prometheus rule file.
+// The objective is selected by its name, additional query parameters are matched against its labels,
+// for example /api/v1/objectives/api/rules.yaml?namespace=default.
+func rulesFileHandler(logger log.Logger, objectives *objectiveServer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		matchers := []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, labels.MetricName, name)}
+		query := r.URL.Query()
+		keys := make([]string, 0, len(query))
+		for k := range query {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			matchers = append(matchers, labels.MustNewMatcher(labels.MatchEqual, k, query.Get(k)))
+		}
+		expr := make([]string, 0, len(matchers))
+		for _, m := range matchers {
+			expr = append(expr, m.String())
+		}
+
+		objective, err := objectives.getObjective(r.Context(), "/api/v1/objectives/rules.yaml", "{"+strings.Join(expr, ", ")+"}")
+		if err != nil {
+			httpError(w, logger, "failed to get objective", err)
+			return
+		}
+		spec, err := objectiveRuleSpec(objective)
+		if err != nil {
+			httpError(w, logger, "failed to generate rules", err)
+			return
+		}
+		bytes, err := yaml.Marshal(spec)
+		if err != nil {
+			httpError(w, logger, "failed to marshal rules", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".yaml"))
+		_, _ = w.Write(append([]byte(generatedRuleFileHeader), bytes...))
+	}
+}
+
+// maxValidateBodyBytes limits the size of objective configs sent to validateHandler.
+const maxValidateBodyBytes = 1 << 20
+
+type validateResponse struct {
+	Name     string   `json:"name,omitempty"`
+	Rules    []string `json:"rules,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// validateHandler validates the YAML or JSON objective config of the request body, like the operators and pyrra lint.
+// It responds with the names of the generated rules, or with 400 and the problems of invalid objectives.
+// This way CI pipelines can check objectives before applying them.
+func validateHandler(logger log.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		bytes, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxValidateBodyBytes))
+		if err != nil {
+			httpError(w, logger, "failed to read objective", connect.NewError(connect.CodeInvalidArgument, err))
+			return
+		}
+
+		resp := validateObjective(bytes)
+		status := http.StatusOK
+		if len(resp.Errors) > 0 {
+			status = http.StatusBadRequest
 		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(resp)
+	}
+}
+
+// validateObjective returns the generated rule names of the objective config, or its problems if invalid.
+func validateObjective(bytes []byte) validateResponse {
+	kubeObjective, objective, err := parseObjective(bytes)
+	if err != nil {
+		return validateResponse{Errors: []string{err.Error()}}
+	}
+
+	warnings, rules, err := validatedRules(kubeObjective, objective)
+	if err != nil {
+		return validateResponse{Name: objective.Name(), Warnings: warnings, Errors: []string{err.Error()}}
+	}
+	if problems := lintObjective(objective, rules); len(problems) > 0 {
+		return validateResponse{Name: objective.Name(), Warnings: warnings, Errors: problems}
 	}
 
-	return connect.NewResponse(&objectivesv1alpha1.GetAlertsResponse{Alerts: alerts}), nil
+	resp := validateResponse{Name: objective.Name(), Warnings: warnings}
+	for _, group := range rules.Groups {
+		for _, rule := range group.Rules {
+			name := rule.Record
+			if rule.Alert != "" {
+				name = rule.Alert
+			}
+			// All multi burn rate alerts share the same name.
+			if !slices.Contains(resp.Rules, name) {
+				resp.Rules = append(resp.Rules, name)
+			}
+		}
+	}
+	return resp
 }
 
 // alertsMatchingObjectives loops through all alerts trying to match objectives based on their labels.
@@ -1154,19 +3450,18 @@ func alertsMatchingObjectives(metrics model.Vector, objectives []slo.Objective,
 				queryLong, _ := o.QueryBurnrate(w.Long, grouping)
 
 				alerts = append(alerts, &objectivesv1alpha1.Alert{
-					Labels:   lset,
-					Severity: string(w.Severity),
-					For:      durationpb.New(w.For),
-					Factor:   w.Factor,
+					Labels:    lset,
+					Severity:  string(w.Severity),
+					For:       durationpb.New(w.For),
+					Factor:    w.Factor,
+					Threshold: w.Factor * (1 - o.Target),
 					Short: &objectivesv1alpha1.Burnrate{
-						Window:  durationpb.New(w.Short),
-						Current: -1,
-						Query:   queryShort,
+						Window: durationpb.New(w.Short),
+						Query:  queryShort,
 					},
 					Long: &objectivesv1alpha1.Burnrate{
-						Window:  durationpb.New(w.Long),
-						Current: -1,
-						Query:   queryLong,
+						Window: durationpb.New(w.Long),
+						Query:  queryLong,
 					},
 					State: objectivesv1alpha1.Alert_inactive,
 				})
@@ -1251,17 +3546,16 @@ func alertsMatchingObjectives(metrics model.Vector, objectives []slo.Objective,
 							string(sample.Metric["alertstate"]),
 						)],
 					),
-					For:    durationpb.New(window.For),
-					Factor: window.Factor,
+					For:       durationpb.New(window.For),
+					Factor:    window.Factor,
+					Threshold: window.Factor * (1 - o.Target),
 					Short: &objectivesv1alpha1.Burnrate{
-						Window:  durationpb.New(time.Duration(short)),
-						Current: -1,
-						Query:   o.Burnrate(time.Duration(short)),
+						Window: durationpb.New(time.Duration(short)),
+						Query:  o.Burnrate(time.Duration(short)),
 					},
 					Long: &objectivesv1alpha1.Burnrate{
-						Window:  durationpb.New(time.Duration(long)),
-						Current: -1,
-						Query:   o.Burnrate(time.Duration(long)),
+						Window: durationpb.New(time.Duration(long)),
+						Query:  o.Burnrate(time.Duration(long)),
 					},
 				})
 			} else {
@@ -1295,7 +3589,7 @@ func alertsMatchingObjectives(metrics model.Vector, objectives []slo.Objective,
 }
 
 func (s *objectiveServer) GraphRate(ctx context.Context, req *connect.Request[objectivesv1alpha1.GraphRateRequest]) (*connect.Response[objectivesv1alpha1.GraphRateResponse], error) {
-	objective, err := s.getObjective(ctx, req.Msg.Expr)
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
 	if err != nil {
 		return nil, err
 	}
@@ -1306,63 +3600,57 @@ func (s *objectiveServer) GraphRate(ctx context.Context, req *connect.Request[ob
 		if err != nil {
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to parse expr: %w", err))
 		}
-		if objective.Indicator.Ratio != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Ratio.Errors.LabelMatchers = append(objective.Indicator.Ratio.Errors.LabelMatchers, m)
-				objective.Indicator.Ratio.Total.LabelMatchers = append(objective.Indicator.Ratio.Total.LabelMatchers, m)
-			}
-		}
-		if objective.Indicator.Latency != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Latency.Success.LabelMatchers = append(objective.Indicator.Latency.Success.LabelMatchers, m)
-				objective.Indicator.Latency.Total.LabelMatchers = append(objective.Indicator.Latency.Total.LabelMatchers, m)
-			}
-		}
-		if objective.Indicator.BoolGauge != nil {
-			objective.Indicator.BoolGauge.LabelMatchers = append(objective.Indicator.BoolGauge.LabelMatchers, groupingMatchers...)
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
 		}
+		objective = mergeGrouping(objective, groupingMatchers)
 	}
 
-	end := time.Now()
+	end := s.now()
 	start := end.Add(-1 * time.Hour)
 
-	if !req.Msg.Start.AsTime().IsZero() && !req.Msg.End.AsTime().IsZero() {
+	if req.Msg.Start != nil && req.Msg.End != nil {
 		start = req.Msg.Start.AsTime()
 		end = req.Msg.End.AsTime()
+		if err := s.checkQueryRange(start, end); err != nil {
+			return nil, err
+		}
 	}
-	step := end.Sub(start) / 1000
+	step := s.queryStep(start, end)
 
-	timeRange := rangeInterval(start, end)
+	timeRange := rangeInterval(start, end, s.rateWindow)
 	cacheDuration := rangeCache(start, end)
 
 	query := objective.RequestRange(timeRange)
+	if req.Msg.Aggregate != "" {
+		query, err = aggregateQuery(query, req.Msg.Aggregate, objective.Grouping())
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	value, _, err := s.promAPI.QueryRange(contextSetPromCache(ctx, cacheDuration), query, prometheusapiv1.Range{
+	value, warnings, err := s.promAPI.QueryRange(contextSetPromCache(ctx, cacheDuration), query, prometheusapiv1.Range{
 		Start: start,
 		End:   end,
 		Step:  step,
 	})
 	if err != nil {
 		level.Warn(s.logger).Log("msg", "failed to run range request", "query", query, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	if value.Type() != model.ValMatrix {
-		err := fmt.Errorf("returned data is not a matrix")
-		level.Warn(s.logger).Log("query", query, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
 	}
 
 	matrix, ok := value.(model.Matrix)
 	if !ok {
-		err := fmt.Errorf("no matrix returned")
-		level.Warn(s.logger).Log("query", query, "err", err)
+		err := errUnexpectedValue(model.ValMatrix, value)
+		level.Warn(s.logger).Log("msg", "returned data wasn't of type matrix", "query", query, "err", err)
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	if len(matrix) == 0 {
 		level.Debug(s.logger).Log("msg", "no data returned", "query", query)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		if !s.demo {
+			return nil, connect.NewError(connect.CodeNotFound, errNoData)
+		}
 	}
 
 	valueLength := 0
@@ -1386,15 +3674,17 @@ func (s *objectiveServer) GraphRate(ctx context.Context, req *connect.Request[ob
 
 	return connect.NewResponse(&objectivesv1alpha1.GraphRateResponse{
 		Timeseries: &objectivesv1alpha1.Timeseries{
-			Labels: labels,
-			Query:  query,
-			Series: series,
+			Labels:   labels,
+			Query:    query,
+			Series:   series,
+			Step:     durationpb.New(step),
+			Warnings: warnings,
 		},
 	}), nil
 }
 
 func (s *objectiveServer) GraphErrors(ctx context.Context, req *connect.Request[objectivesv1alpha1.GraphErrorsRequest]) (*connect.Response[objectivesv1alpha1.GraphErrorsResponse], error) {
-	objective, err := s.getObjective(ctx, req.Msg.Expr)
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
 	if err != nil {
 		return nil, err
 	}
@@ -1405,62 +3695,50 @@ func (s *objectiveServer) GraphErrors(ctx context.Context, req *connect.Request[
 		if err != nil {
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to parse expr: %w", err))
 		}
-		if objective.Indicator.Ratio != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Ratio.Errors.LabelMatchers = append(objective.Indicator.Ratio.Errors.LabelMatchers, m)
-				objective.Indicator.Ratio.Total.LabelMatchers = append(objective.Indicator.Ratio.Total.LabelMatchers, m)
-			}
-		}
-		if objective.Indicator.Latency != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Latency.Success.LabelMatchers = append(objective.Indicator.Latency.Success.LabelMatchers, m)
-				objective.Indicator.Latency.Total.LabelMatchers = append(objective.Indicator.Latency.Total.LabelMatchers, m)
-			}
-		}
-		if objective.Indicator.BoolGauge != nil {
-			objective.Indicator.BoolGauge.LabelMatchers = append(objective.Indicator.BoolGauge.LabelMatchers, groupingMatchers...)
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
 		}
+		objective = mergeGrouping(objective, groupingMatchers)
 	}
 
-	end := time.Now()
+	end := s.now()
 	start := end.Add(-1 * time.Hour)
 
-	if !req.Msg.Start.AsTime().IsZero() && !req.Msg.End.AsTime().IsZero() {
+	if req.Msg.Start != nil && req.Msg.End != nil {
 		start = req.Msg.Start.AsTime()
 		end = req.Msg.End.AsTime()
+		if err := s.checkQueryRange(start, end); err != nil {
+			return nil, err
+		}
 	}
-	step := end.Sub(start) / 1000
+	step := s.queryStep(start, end)
 
-	timeRange := rangeInterval(start, end)
+	timeRange := rangeInterval(start, end, s.rateWindow)
 	cacheDuration := rangeCache(start, end)
 
 	query := objective.ErrorsRange(timeRange)
-	value, _, err := s.promAPI.QueryRange(contextSetPromCache(ctx, cacheDuration), query, prometheusapiv1.Range{
+	value, warnings, err := s.promAPI.QueryRange(contextSetPromCache(ctx, cacheDuration), query, prometheusapiv1.Range{
 		Start: start,
 		End:   end,
 		Step:  step,
 	})
 	if err != nil {
 		level.Warn(s.logger).Log("msg", "failed to run range error request", "query", query, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
-
-	if value.Type() != model.ValMatrix {
-		err := fmt.Errorf("returned data is not a matrix")
-		level.Warn(s.logger).Log("query", query, "err", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
 	}
 
 	matrix, ok := value.(model.Matrix)
 	if !ok {
-		err := fmt.Errorf("no matrix returned")
-		level.Warn(s.logger).Log("query", query, "err", err)
+		err := errUnexpectedValue(model.ValMatrix, value)
+		level.Warn(s.logger).Log("msg", "returned data wasn't of type matrix", "query", query, "err", err)
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	if len(matrix) == 0 {
 		level.Debug(s.logger).Log("msg", "no data returned", "query", query)
-		return nil, connect.NewError(connect.CodeNotFound, err)
+		if !s.demo {
+			return nil, connect.NewError(connect.CodeNotFound, errNoData)
+		}
 	}
 
 	valueLength := 0
@@ -1485,9 +3763,11 @@ func (s *objectiveServer) GraphErrors(ctx context.Context, req *connect.Request[
 
 	return connect.NewResponse(&objectivesv1alpha1.GraphErrorsResponse{
 		Timeseries: &objectivesv1alpha1.Timeseries{
-			Labels: labels,
-			Query:  query,
-			Series: series,
+			Labels:   labels,
+			Query:    query,
+			Series:   series,
+			Step:     durationpb.New(step),
+			Warnings: warnings,
 		},
 	}), nil
 }
@@ -1495,57 +3775,66 @@ func (s *objectiveServer) GraphErrors(ctx context.Context, req *connect.Request[
 var percentiles = []float64{0.999, 0.99, 0.95, 0.9, 0.5}
 
 func (s *objectiveServer) GraphDuration(ctx context.Context, req *connect.Request[objectivesv1alpha1.GraphDurationRequest]) (*connect.Response[objectivesv1alpha1.GraphDurationResponse], error) {
-	objective, err := s.getObjective(ctx, req.Msg.Expr)
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
 	if err != nil {
 		return nil, err
 	}
 
+	if t := objective.IndicatorType(); t != slo.Latency && t != slo.LatencyNative {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("objective has no latency histogram"))
+	}
+
+	for _, p := range req.Msg.Percentiles {
+		if p <= 0 || p >= 1 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("percentile must be between 0 and 1, got %v", p))
+		}
+	}
+
 	// Merge grouping into objective's query
 	if req.Msg.Grouping != "" {
 		groupingMatchers, err := parser.ParseMetricSelector(req.Msg.Grouping)
 		if err != nil {
 			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed to parse expr: %w", err))
 		}
-		if objective.Indicator.Ratio != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Ratio.Errors.LabelMatchers = append(objective.Indicator.Ratio.Errors.LabelMatchers, m)
-				objective.Indicator.Ratio.Total.LabelMatchers = append(objective.Indicator.Ratio.Total.LabelMatchers, m)
-			}
-		}
-		if objective.Indicator.Latency != nil {
-			for _, m := range groupingMatchers {
-				objective.Indicator.Latency.Success.LabelMatchers = append(objective.Indicator.Latency.Success.LabelMatchers, m)
-				objective.Indicator.Latency.Total.LabelMatchers = append(objective.Indicator.Latency.Total.LabelMatchers, m)
-			}
-		}
-		if objective.Indicator.BoolGauge != nil {
-			objective.Indicator.BoolGauge.LabelMatchers = append(objective.Indicator.BoolGauge.LabelMatchers, groupingMatchers...)
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
 		}
+		objective = mergeGrouping(objective, groupingMatchers)
 	}
 
-	end := time.Now()
+	end := s.now()
 	start := end.Add(-1 * time.Hour)
 
-	if !req.Msg.Start.AsTime().IsZero() && !req.Msg.End.AsTime().IsZero() {
+	if req.Msg.Start != nil && req.Msg.End != nil {
 		start = req.Msg.Start.AsTime()
 		end = req.Msg.End.AsTime()
+		if err := s.checkQueryRange(start, end); err != nil {
+			return nil, err
+		}
 	}
-	step := end.Sub(start) / 1000
+	step := s.queryStep(start, end)
 
-	timeRange := rangeInterval(start, end)
+	timeRange := rangeInterval(start, end, s.rateWindow)
 	cacheDuration := rangeCache(start, end)
 
 	timeseries := make([]*objectivesv1alpha1.Timeseries, 0, len(percentiles))
 
-	objectivePercentiles := percentiles
-	contains := false
-	for _, p := range percentiles {
-		if p == objective.Target {
-			contains = true
+	requested := len(req.Msg.Percentiles) > 0
+
+	objectivePercentiles := make([]float64, 0, len(percentiles)+1)
+	if requested {
+		objectivePercentiles = append(objectivePercentiles, req.Msg.Percentiles...)
+	} else {
+		objectivePercentiles = append(objectivePercentiles, percentiles...)
+		contains := false
+		for _, p := range percentiles {
+			if p == objective.Target {
+				contains = true
+			}
+		}
+		if !contains {
+			objectivePercentiles = append(objectivePercentiles, objective.Target)
 		}
-	}
-	if !contains {
-		objectivePercentiles = append(objectivePercentiles, objective.Target)
 	}
 
 	// sort in descending order
@@ -1554,34 +3843,30 @@ func (s *objectiveServer) GraphDuration(ctx context.Context, req *connect.Reques
 	})
 
 	for _, percentile := range objectivePercentiles {
-		if objective.Target >= percentile {
+		if requested || objective.Target >= percentile {
 			query := objective.DurationRange(timeRange, percentile)
-			value, _, err := s.promAPI.QueryRange(contextSetPromCache(ctx, cacheDuration), query, prometheusapiv1.Range{
+			value, warnings, err := s.promAPI.QueryRange(contextSetPromCache(ctx, cacheDuration), query, prometheusapiv1.Range{
 				Start: start,
 				End:   end,
 				Step:  step,
 			})
 			if err != nil {
 				level.Warn(s.logger).Log("msg", "failed to run range error request", "query", query, "err", err)
-				return nil, connect.NewError(connect.CodeInternal, err)
-			}
-
-			if value.Type() != model.ValMatrix {
-				err := fmt.Errorf("returned data is not a matrix")
-				level.Warn(s.logger).Log("query", query, "err", err)
-				return nil, connect.NewError(connect.CodeInternal, err)
+				return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
 			}
 
 			matrix, ok := value.(model.Matrix)
 			if !ok {
-				err := fmt.Errorf("no matrix returned")
-				level.Warn(s.logger).Log("query", query, "err", err)
+				err := errUnexpectedValue(model.ValMatrix, value)
+				level.Warn(s.logger).Log("msg", "returned data wasn't of type matrix", "query", query, "err", err)
 				return nil, connect.NewError(connect.CodeInternal, err)
 			}
 
 			if len(matrix) == 0 {
 				level.Debug(s.logger).Log("msg", "no data returned", "query", query)
-				return nil, connect.NewError(connect.CodeNotFound, err)
+				if !s.demo {
+					return nil, connect.NewError(connect.CodeNotFound, errNoData)
+				}
 			}
 
 			valueLength := 0
@@ -1600,9 +3885,11 @@ func (s *objectiveServer) GraphDuration(ctx context.Context, req *connect.Reques
 
 			timeseries = append(timeseries,
 				&objectivesv1alpha1.Timeseries{
-					Labels: []string{fmt.Sprintf(`{quantile="p%.f"}`, 100*percentile)}, // TODO: Nicer format float
-					Query:  query,
-					Series: series,
+					Labels:   []string{fmt.Sprintf(`{quantile="p%.f"}`, 100*percentile)}, // TODO: Nicer format float
+					Query:    query,
+					Series:   series,
+					Step:     durationpb.New(step),
+					Warnings: warnings,
 				},
 			)
 		}
@@ -1613,6 +3900,108 @@ func (s *objectiveServer) GraphDuration(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
+// GraphAlerts returns the state of the objective's multi burn rate alerts over time, e.g. to review incidents.
+// Ranges without any alerts return no series instead of an error.
+func (s *objectiveServer) GraphAlerts(ctx context.Context, req *connect.Request[objectivesv1alpha1.GraphAlertsRequest]) (*connect.Response[objectivesv1alpha1.GraphAlertsResponse], error) {
+	objective, err := s.getObjective(ctx, req.Spec().Procedure, req.Msg.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupingMatchers []*labels.Matcher
+	if req.Msg.Grouping != "" && req.Msg.Grouping != "{}" {
+		groupingMatchers, err = parser.ParseMetricSelector(req.Msg.Grouping)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("failed parsing grouping matchers: %w", err))
+		}
+		if err := validateGrouping(objective, groupingMatchers); err != nil {
+			return nil, err
+		}
+	}
+
+	end := s.now()
+	start := end.Add(-1 * time.Hour)
+
+	if req.Msg.Start != nil && req.Msg.End != nil {
+		start = req.Msg.Start.AsTime()
+		end = req.Msg.End.AsTime()
+		if err := s.checkQueryRange(start, end); err != nil {
+			return nil, err
+		}
+	}
+	step := s.queryStep(start, end)
+
+	query := alertsRangeQuery(objective, groupingMatchers)
+	value, warnings, err := s.promAPI.QueryRange(contextSetPromCache(ctx, rangeCache(start, end)), query, prometheusapiv1.Range{
+		Start: start,
+		End:   end,
+		Step:  step,
+	})
+	if err != nil {
+		level.Warn(s.logger).Log("msg", "failed to run range alerts request", "query", query, "err", err)
+		return nil, connect.NewError(queryErrorCode(err), s.exposeQuery(query, err))
+	}
+
+	matrix, ok := value.(model.Matrix)
+	if !ok {
+		err := errUnexpectedValue(model.ValMatrix, value)
+		level.Warn(s.logger).Log("msg", "returned data wasn't of type matrix", "query", query, "err", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	seriesLabels := make([]string, len(matrix))
+	for i, stream := range matrix {
+		seriesLabels[i] = model.LabelSet(stream.Metric).String()
+	}
+
+	values := matrixToValues(matrix)
+	series := make([]*objectivesv1alpha1.Series, 0, len(values))
+	for _, float64s := range values {
+		series = append(series, &objectivesv1alpha1.Series{Values: float64s})
+	}
+
+	return connect.NewResponse(&objectivesv1alpha1.GraphAlertsResponse{
+		Timeseries: &objectivesv1alpha1.Timeseries{
+			Labels:   seriesLabels,
+			Query:    query,
+			Series:   series,
+			Step:     durationpb.New(step),
+			Warnings: warnings,
+		},
+	}), nil
+}
+
+// alertsRangeQuery returns a query for the state of the objective's alerts, one series per short and long window and severity.
+// Pending alerts are 1, firing alerts are 2 and inactive alerts have no samples.
+func alertsRangeQuery(objective slo.Objective, groupingMatchers []*labels.Matcher) string {
+	selector := func(state string) string {
+		matchers := make([]string, 0, len(objective.Labels)+1+len(groupingMatchers))
+		for _, l := range objective.Labels {
+			switch {
+			case l.Name == labels.MetricName:
+				// The __name__ is called slo in the ALERTS metrics.
+				matchers = append(matchers, labels.MustNewMatcher(labels.MatchEqual, "slo", l.Value).String())
+			case strings.HasPrefix(l.Name, slo.PropagationLabelsPrefix):
+				// Propagated labels are always added to the alerts without the prefix.
+				name := strings.TrimPrefix(l.Name, slo.PropagationLabelsPrefix)
+				matchers = append(matchers, labels.MustNewMatcher(labels.MatchEqual, name, l.Value).String())
+			case model.LabelName(l.Name).IsValidLegacy():
+				// Other labels, like the namespace, might not be on the alerts, but if they are they need to match.
+				matchers = append(matchers, labels.MustNewMatcher(labels.MatchRegexp, l.Name, regexp.QuoteMeta(l.Value)+"|").String())
+			}
+		}
+		matchers = append(matchers, labels.MustNewMatcher(labels.MatchEqual, "alertstate", state).String())
+		for _, m := range groupingMatchers {
+			if m.Name == labels.MetricName || m.Name == "slo" || m.Name == "alertstate" {
+				continue
+			}
+			matchers = append(matchers, m.String())
+		}
+		return fmt.Sprintf("ALERTS{%s}", strings.Join(matchers, ", "))
+	}
+	return fmt.Sprintf("max by (short, long, severity) (%s * 2 or %s)", selector("firing"), selector("pending"))
+}
+
 const (
 	hours12 = 12 * time.Hour
 	day     = 24 * time.Hour
@@ -1620,18 +4009,24 @@ const (
 	month   = 4 * week
 )
 
-func rangeInterval(start, end time.Time) time.Duration {
+// rangeInterval returns the rate window for graphs between start and end.
+// Longer time ranges use multiples of the base window, which defaults to 5m.
+func rangeInterval(start, end time.Time, base time.Duration) time.Duration {
+	if base <= 0 {
+		base = 5 * time.Minute
+	}
+
 	diff := end.Sub(start)
-	d := 5 * time.Minute
+	d := base
 	// TODO: Refactor for early returns instead
 	if diff >= month {
-		d = 3 * time.Hour
+		d = 36 * base
 	} else if diff >= week {
-		d = time.Hour
+		d = 12 * base
 	} else if diff >= day {
-		d = 30 * time.Minute
+		d = 6 * base
 	} else if diff >= hours12 {
-		d = 15 * time.Minute
+		d = 3 * base
 	}
 	return d
 }
@@ -1655,6 +4050,8 @@ func instantCache(duration time.Duration) time.Duration {
 	return d
 }
 
+// matrixToValues returns the timestamps followed by the values of each series, aligned by timestamp.
+// Missing samples are NaN, as are NaN samples, for them to be shown as gaps instead of zeros.
 func matrixToValues(m model.Matrix) [][]float64 {
 	series := len(m)
 	if series == 0 {
@@ -1662,65 +4059,88 @@ func matrixToValues(m model.Matrix) [][]float64 {
 	}
 
 	if series == 1 {
-		vs := make([][]float64, len(m)+1) // +1 for timestamps
-		for i, stream := range m {
-			vs[0] = make([]float64, len(stream.Values))
-			vs[i+1] = make([]float64, len(stream.Values))
-
-			for j, pair := range stream.Values {
-				vs[0][j] = float64(pair.Timestamp / 1000)
-				if !math.IsNaN(float64(pair.Value)) {
-					vs[i+1][j] = float64(pair.Value)
-				}
-			}
+		// A single series is already sorted by timestamp and doesn't need to be merged.
+		stream := m[0]
+		timestamps := make([]float64, len(stream.Values))
+		samples := make([]float64, len(stream.Values))
+		for i, pair := range stream.Values {
+			timestamps[i] = float64(pair.Timestamp / 1000)
+			samples[i] = float64(pair.Value)
 		}
-		return vs
+		return [][]float64{timestamps, samples}
 	}
 
-	pairs := make(map[int64][]float64, len(m[0].Values))
-	for i, stream := range m {
-		for _, pair := range stream.Values {
-			t := int64(pair.Timestamp / 1000)
-			if _, ok := pairs[t]; !ok {
-				pairs[t] = make([]float64, series)
-			}
-			if !math.IsNaN(float64(pair.Value)) {
-				pairs[t][i] = float64(pair.Value)
-			}
+	// Prometheus returns each series sorted by timestamp and evaluates range queries at the same steps for all series.
+	// The longest series' timestamps are therefore usually the timeline of all series,
+	// only series with other timestamps, e.g. after a gap in the longest series, are merged into it.
+	longest := m[0]
+	for _, stream := range m[1:] {
+		if len(stream.Values) > len(longest.Values) {
+			longest = stream
 		}
 	}
-
-	vs := make(values, series+1)
-	for i := 0; i < series+1; i++ {
-		vs[i] = make([]float64, len(pairs))
+	timeline := mergeTimestamps(nil, longest.Values)
+	for _, stream := range m {
+		if !coversTimestamps(timeline, stream.Values) {
+			timeline = mergeTimestamps(timeline, stream.Values)
+		}
 	}
-	var i int
-	for t, fs := range pairs {
+
+	vs := make([][]float64, series+1)
+	vs[0] = make([]float64, len(timeline))
+	for i, t := range timeline {
 		vs[0][i] = float64(t)
-		for j, f := range fs {
-			vs[j+1][i] = f
+	}
+	for i, stream := range m {
+		samples := make([]float64, len(timeline))
+		j := 0
+		for k, t := range timeline {
+			samples[k] = math.NaN()
+			// Samples within the same second collapse into the last one.
+			for ; j < len(stream.Values) && int64(stream.Values[j].Timestamp/1000) == t; j++ {
+				samples[k] = float64(stream.Values[j].Value)
+			}
 		}
-		i++
+		vs[i+1] = samples
 	}
 
-	sort.Sort(vs)
-
 	return vs
 }
 
-type values [][]float64
-
-func (v values) Len() int {
-	return len(v[0])
+// coversTimestamps returns true if the sorted timeline contains the second of every sample.
+func coversTimestamps(timeline []int64, pairs []model.SamplePair) bool {
+	i := 0
+	for _, pair := range pairs {
+		t := int64(pair.Timestamp / 1000)
+		for i < len(timeline) && timeline[i] < t {
+			i++
+		}
+		if i == len(timeline) || timeline[i] != t {
+			return false
+		}
+	}
+	return true
 }
 
-func (v values) Less(i, j int) bool {
-	return v[0][i] < v[0][j]
-}
+// mergeTimestamps returns the sorted union of the timeline and the seconds of the samples, which are sorted by timestamp.
+func mergeTimestamps(timeline []int64, pairs []model.SamplePair) []int64 {
+	merged := make([]int64, 0, max(len(timeline), len(pairs)))
+	add := func(t int64) {
+		if len(merged) == 0 || merged[len(merged)-1] != t {
+			merged = append(merged, t)
+		}
+	}
 
-// Swap iterates over all []float64 and consistently swaps them.
-func (v values) Swap(i, j int) {
-	for n := range v {
-		v[n][i], v[n][j] = v[n][j], v[n][i]
+	i := 0
+	for _, pair := range pairs {
+		t := int64(pair.Timestamp / 1000)
+		for ; i < len(timeline) && timeline[i] < t; i++ {
+			add(timeline[i])
+		}
+		add(t)
+	}
+	for ; i < len(timeline); i++ {
+		add(timeline[i])
 	}
+	return merged
 }
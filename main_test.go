@@ -1,16 +1,59 @@
 package main
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"math"
+	"math/big"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
 	"time"
 
+	"github.com/alecthomas/kong"
+	"github.com/bufbuild/connect-go"
+	"github.com/dgraph-io/ristretto"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-kit/log"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/client_golang/api"
+	prometheusapiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	promconfig "github.com/prometheus/common/config"
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/yaml"
 
 	objectivesv1alpha1 "github.com/pyrra-dev/pyrra/proto/objectives/v1alpha1"
+	"github.com/pyrra-dev/pyrra/proto/objectives/v1alpha1/objectivesv1alpha1connect"
 	"github.com/pyrra-dev/pyrra/slo"
 )
 
@@ -53,6 +96,8 @@ func TestMatrixToValues(t *testing.T) {
 	}
 	for i := 0; i < 300; i++ {
 		e1[0][i] = float64(i)
+		e1[1][i] = math.NaN()
+		e1[2][i] = math.NaN()
 	}
 	for i := 0; i < 100; i++ {
 		e1[1][i] = float64(i)
@@ -61,8 +106,7 @@ func TestMatrixToValues(t *testing.T) {
 		e1[2][50+i] = float64(i)
 	}
 
-	// Check if NaNs are returned as 0 (it's fine for errors for example to convert these).
-	// Additionally, NaNs aren't possible to be marshalled to JSON. Not sure if there's a better way.
+	// Check if NaNs are returned as NaN to be shown as gaps, not as 0.
 	v2 := make([]model.SamplePair, 100)
 	for i := 0; i < cap(v2); i++ {
 		v2[i] = model.SamplePair{
@@ -76,6 +120,7 @@ func TestMatrixToValues(t *testing.T) {
 	}
 	for i := 0; i < len(e2[0]); i++ {
 		e2[0][i] = float64(i)
+		e2[1][i] = math.NaN()
 	}
 
 	// Check NaN in multiple series
@@ -98,10 +143,10 @@ func TestMatrixToValues(t *testing.T) {
 	for i := 0; i < len(e3[0]); i++ {
 		e32value := float64(i)
 		if i%11 == 0 {
-			e32value = 0
+			e32value = math.NaN()
 		}
 		e3[0][i] = float64(i)
-		e3[1][i] = 0
+		e3[1][i] = math.NaN()
 		e3[2][i] = e32value
 	}
 
@@ -123,13 +168,18 @@ func TestMatrixToValues(t *testing.T) {
 		name:     "NaN",
 		m:        []*model.SampleStream{{Values: v2}},
 		expected: e2,
+	}, {
+		name:     "NaNSingle",
+		m:        []*model.SampleStream{{Values: v3}},
+		expected: [][]float64{e3[0], e3[2]},
 	}, {
 		name:     "NaNMultiple",
 		m:        []*model.SampleStream{{Values: v2}, {Values: v3}},
 		expected: e3,
 	}} {
 		t.Run(tc.name, func(t *testing.T) {
-			require.Equal(t, tc.expected, matrixToValues(tc.m))
+			// NaN never equals NaN, so compare the formatted values instead.
+			require.Equal(t, fmt.Sprint(tc.expected), fmt.Sprint(matrixToValues(tc.m)))
 		})
 	}
 }
@@ -188,6 +238,116 @@ func BenchmarkMatrixToValues(b *testing.B) {
 	})
 }
 
+// matrixToValuesMap is the previous implementation of matrixToValues,
+// merging the series by their timestamps in a map and sorting them afterwards.
+func matrixToValuesMap(m model.Matrix) [][]float64 {
+	series := len(m)
+	if series == 0 {
+		return nil
+	}
+
+	pairs := make(map[int64][]float64, len(m[0].Values))
+	for i, stream := range m {
+		for _, pair := range stream.Values {
+			t := int64(pair.Timestamp / 1000)
+			if _, ok := pairs[t]; !ok {
+				pairs[t] = make([]float64, series)
+				for j := range pairs[t] {
+					pairs[t][j] = math.NaN()
+				}
+			}
+			pairs[t][i] = float64(pair.Value)
+		}
+	}
+
+	timestamps := make([]int64, 0, len(pairs))
+	for t := range pairs {
+		timestamps = append(timestamps, t)
+	}
+	slices.Sort(timestamps)
+
+	vs := make([][]float64, series+1)
+	for i := range vs {
+		vs[i] = make([]float64, len(timestamps))
+	}
+	for i, t := range timestamps {
+		vs[0][i] = float64(t)
+		for j, f := range pairs[t] {
+			vs[j+1][i] = f
+		}
+	}
+	return vs
+}
+
+// randomMatrix returns series with samples every 30s, starting and ending at random steps and with random gaps.
+func randomMatrix(r *rand.Rand, series, steps int) model.Matrix {
+	m := make(model.Matrix, series)
+	for i := range m {
+		start := r.Intn(steps / 4)
+		end := steps - r.Intn(steps/4)
+		stream := &model.SampleStream{Metric: model.Metric{"series": model.LabelValue(strconv.Itoa(i))}}
+		for step := start; step < end; step++ {
+			if r.Intn(10) == 0 {
+				continue // gap
+			}
+			value := model.SampleValue(r.Float64())
+			if r.Intn(20) == 0 {
+				value = model.SampleValue(math.NaN())
+			}
+			stream.Values = append(stream.Values, model.SamplePair{Timestamp: model.Time(step * 30_000), Value: value})
+		}
+		m[i] = stream
+	}
+	return m
+}
+
+func TestMatrixToValuesEquivalence(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		m := randomMatrix(r, 1+r.Intn(8), 8+r.Intn(200))
+		// NaN never equals NaN, so compare the formatted values instead.
+		require.Equal(t, fmt.Sprint(matrixToValuesMap(m)), fmt.Sprint(matrixToValues(m)))
+	}
+
+	// Aligned series without gaps share the longest series' timeline.
+	m := model.Matrix{
+		{Values: []model.SamplePair{{Timestamp: 30_000, Value: 1}, {Timestamp: 60_000, Value: 2}, {Timestamp: 90_000, Value: 3}}},
+		{Values: []model.SamplePair{{Timestamp: 60_000, Value: 4}}},
+		// Samples within the same second collapse into the last one.
+		{Values: []model.SamplePair{{Timestamp: 90_000, Value: 5}, {Timestamp: 90_500, Value: 6}, {Timestamp: 120_000, Value: 7}}},
+	}
+	require.Equal(t, fmt.Sprint(matrixToValuesMap(m)), fmt.Sprint(matrixToValues(m)))
+}
+
+func BenchmarkMatrixToValuesSeries(b *testing.B) {
+	aligned := randomMatrix(rand.New(rand.NewSource(1)), 20, 1000)
+	for _, stream := range aligned {
+		stream.Values = stream.Values[:0]
+		for step := 0; step < 1000; step++ {
+			stream.Values = append(stream.Values, model.SamplePair{Timestamp: model.Time(step * 30_000), Value: 1})
+		}
+	}
+	gaps := randomMatrix(rand.New(rand.NewSource(1)), 20, 1000)
+
+	for _, bc := range []struct {
+		name string
+		m    model.Matrix
+	}{{name: "aligned", m: aligned}, {name: "gaps", m: gaps}} {
+		b.Run(bc.name+"/map", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				matrixToValuesMap(bc.m)
+			}
+		})
+		b.Run(bc.name+"/timeline", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				matrixToValues(bc.m)
+			}
+		})
+	}
+}
+
 func TestAlertsMatchingObjectives(t *testing.T) {
 	testcases := []struct {
 		name       string
@@ -223,19 +383,18 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 				"namespace":       "monitoring",
 				"job":             "prometheus",
 			},
-			Severity: "warning",
-			State:    objectivesv1alpha1.Alert_firing,
-			For:      durationpb.New(90 * time.Minute),
-			Factor:   1,
+			Severity:  "warning",
+			State:     objectivesv1alpha1.Alert_firing,
+			For:       durationpb.New(90 * time.Minute),
+			Factor:    1,
+			Threshold: 1,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Hour),
-				Current: -1,
-				Query:   "",
+				Window: durationpb.New(3 * time.Hour),
+				Query:  "",
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(48 * time.Hour),
-				Current: -1,
-				Query:   "",
+				Window: durationpb.New(48 * time.Hour),
+				Query:  "",
 			},
 		}},
 	}, {
@@ -256,17 +415,16 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 				//"alertname":       "ErrorBudgetBurn",
 				//"job":             "prometheus",
 			},
-			Severity: "critical",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(time.Minute),
-			Factor:   14,
+			Severity:  "critical",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(time.Minute),
+			Factor:    14,
+			Threshold: 14,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Minute),
-				Current: -1,
+				Window: durationpb.New(3 * time.Minute),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(30 * time.Minute),
-				Current: -1,
+				Window: durationpb.New(30 * time.Minute),
 			},
 		}, {
 			Labels: map[string]string{
@@ -275,17 +433,16 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 				//"alertname":       "ErrorBudgetBurn",
 				//"job":             "prometheus",
 			},
-			Severity: "critical",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(8 * time.Minute),
-			Factor:   7,
+			Severity:  "critical",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(8 * time.Minute),
+			Factor:    7,
+			Threshold: 7,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(15 * time.Minute),
-				Current: -1,
+				Window: durationpb.New(15 * time.Minute),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(3 * time.Hour),
 			},
 		}, {
 			Labels: map[string]string{
@@ -294,17 +451,16 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 				//"alertname":       "ErrorBudgetBurn",
 				//"job":             "prometheus",
 			},
-			Severity: "warning",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(30 * time.Minute),
-			Factor:   2,
+			Severity:  "warning",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(30 * time.Minute),
+			Factor:    2,
+			Threshold: 2,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(time.Hour),
-				Current: -1,
+				Window: durationpb.New(time.Hour),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(12 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(12 * time.Hour),
 			},
 		}, {
 			Labels: map[string]string{
@@ -313,17 +469,16 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 				//"alertname":       "ErrorBudgetBurn",
 				//"job":             "prometheus",
 			},
-			Severity: "warning",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(90 * time.Minute),
-			Factor:   1,
+			Severity:  "warning",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(90 * time.Minute),
+			Factor:    1,
+			Threshold: 1,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(3 * time.Hour),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(48 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(48 * time.Hour),
 			},
 		}},
 	}, {
@@ -353,68 +508,64 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 				labels.MetricName: "prometheus-rule-evaluation-failures",
 				"namespace":       "monitoring",
 			},
-			Severity: "critical",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(time.Minute),
-			Factor:   14,
+			Severity:  "critical",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(time.Minute),
+			Factor:    14,
+			Threshold: 14,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Minute),
-				Current: -1,
+				Window: durationpb.New(3 * time.Minute),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(30 * time.Minute),
-				Current: -1,
+				Window: durationpb.New(30 * time.Minute),
 			},
 		}, {
 			Labels: map[string]string{
 				labels.MetricName: "prometheus-rule-evaluation-failures",
 				"namespace":       "monitoring",
 			},
-			Severity: "critical",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(8 * time.Minute),
-			Factor:   7,
+			Severity:  "critical",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(8 * time.Minute),
+			Factor:    7,
+			Threshold: 7,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(15 * time.Minute),
-				Current: -1,
+				Window: durationpb.New(15 * time.Minute),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(3 * time.Hour),
 			},
 		}, {
 			Labels: map[string]string{
 				labels.MetricName: "prometheus-rule-evaluation-failures",
 				"namespace":       "monitoring",
 			},
-			Severity: "warning",
-			State:    objectivesv1alpha1.Alert_inactive,
-			For:      durationpb.New(30 * time.Minute),
-			Factor:   2,
+			Severity:  "warning",
+			State:     objectivesv1alpha1.Alert_inactive,
+			For:       durationpb.New(30 * time.Minute),
+			Factor:    2,
+			Threshold: 2,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(time.Hour),
-				Current: -1,
+				Window: durationpb.New(time.Hour),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(12 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(12 * time.Hour),
 			},
 		}, {
 			Labels: map[string]string{
 				labels.MetricName: "prometheus-rule-evaluation-failures",
 				"namespace":       "monitoring",
 			},
-			Severity: "warning",
-			State:    objectivesv1alpha1.Alert_firing,
-			For:      durationpb.New(90 * time.Minute),
-			Factor:   1,
+			Severity:  "warning",
+			State:     objectivesv1alpha1.Alert_firing,
+			For:       durationpb.New(90 * time.Minute),
+			Factor:    1,
+			Threshold: 1,
 			Short: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(3 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(3 * time.Hour),
 			},
 			Long: &objectivesv1alpha1.Burnrate{
-				Window:  durationpb.New(48 * time.Hour),
-				Current: -1,
+				Window: durationpb.New(48 * time.Hour),
 			},
 		}},
 	}}
@@ -424,3 +575,2893 @@ func TestAlertsMatchingObjectives(t *testing.T) {
 		})
 	}
 }
+
+type fakePrometheusAPI struct {
+	mu       sync.Mutex
+	queries  int
+	queried  []string
+	inflight int
+	// maxInflight is the highest number of queries that were running concurrently.
+	maxInflight int
+	delay       time.Duration
+	value       func(query string, ts time.Time) model.Value
+	warnings    prometheusapiv1.Warnings
+	// errs are returned by the first queries, one error per query.
+	errs []error
+}
+
+func (f *fakePrometheusAPI) Query(ctx context.Context, query string, ts time.Time, _ ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
+	if err := f.record(query); err != nil {
+		return nil, nil, err
+	}
+	if err := f.wait(ctx); err != nil {
+		return nil, nil, err
+	}
+	return f.value(query, ts), f.warnings, nil
+}
+
+func (f *fakePrometheusAPI) QueryRange(ctx context.Context, query string, r prometheusapiv1.Range, _ ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
+	if err := f.record(query); err != nil {
+		return nil, nil, err
+	}
+	if err := f.wait(ctx); err != nil {
+		return nil, nil, err
+	}
+	return f.value(query, r.End), f.warnings, nil
+}
+
+func (f *fakePrometheusAPI) record(query string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.queries++
+	f.queried = append(f.queried, query)
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return err
+	}
+	return nil
+}
+
+func (f *fakePrometheusAPI) wait(ctx context.Context) error {
+	f.mu.Lock()
+	f.inflight++
+	f.maxInflight = max(f.maxInflight, f.inflight)
+	f.mu.Unlock()
+	defer func() {
+		f.mu.Lock()
+		f.inflight--
+		f.mu.Unlock()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(f.delay):
+		return nil
+	}
+}
+
+type fakeBackendClient struct {
+	objectives []slo.Objective
+	err        error
+	lists      int
+}
+
+func (f *fakeBackendClient) List(_ context.Context, _ *connect.Request[objectivesv1alpha1.ListRequest]) (*connect.Response[objectivesv1alpha1.ListResponse], error) {
+	f.lists++
+	if f.err != nil {
+		return nil, f.err
+	}
+	objectives := make([]*objectivesv1alpha1.Objective, 0, len(f.objectives))
+	for _, o := range f.objectives {
+		objectives = append(objectives, objectivesv1alpha1.FromInternal(o))
+	}
+	return connect.NewResponse(&objectivesv1alpha1.ListResponse{Objectives: objectives}), nil
+}
+
+func newTestPromCache(t *testing.T, api prometheusAPI) *promCache {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1e3,
+		MaxCost:     1 << 20,
+		BufferItems: 64,
+	})
+	require.NoError(t, err)
+	t.Cleanup(cache.Close)
+
+	return &promCache{
+		api:   api,
+		cache: cache,
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "pyrra_api_cache_requests_total",
+		}, []string{"type", "result"}),
+	}
+}
+
+func TestPromCacheQueryTimestamp(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Vector{{Value: model.SampleValue(ts.Unix()), Timestamp: model.TimeFromUnix(ts.Unix())}}
+	}}
+	pc := newTestPromCache(t, api)
+	ctx := contextSetPromCache(context.Background(), time.Minute)
+
+	ts1 := time.Unix(1_700_000_000, 0)
+	ts2 := ts1.Add(5 * time.Minute)
+
+	value, _, err := pc.Query(ctx, "up", ts1)
+	require.NoError(t, err)
+	require.Equal(t, model.SampleValue(ts1.Unix()), value.(model.Vector)[0].Value)
+	pc.cache.Wait()
+
+	// Same query and timestamp is served from the cache.
+	value, _, err = pc.Query(ctx, "up", ts1)
+	require.NoError(t, err)
+	require.Equal(t, model.SampleValue(ts1.Unix()), value.(model.Vector)[0].Value)
+	require.Equal(t, 1, api.queries)
+
+	// Same query at a different timestamp must not return the cached result.
+	value, _, err = pc.Query(ctx, "up", ts2)
+	require.NoError(t, err)
+	require.Equal(t, model.SampleValue(ts2.Unix()), value.(model.Vector)[0].Value)
+	require.Equal(t, 2, api.queries)
+}
+
+func TestPromCacheQueryCacheDuration(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Vector{{Value: model.SampleValue(ts.Unix()), Timestamp: model.TimeFromUnix(ts.Unix())}}
+	}}
+	pc := newTestPromCache(t, api)
+	ctx := contextSetPromCache(context.Background(), 5*time.Minute)
+
+	// Explicitly requested times keep their own results, rounded by 10s.
+	ts := time.Unix(1_700_000_100, 0)
+	_, _, err := pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+	pc.cache.Wait()
+	_, _, err = pc.Query(ctx, "up", ts.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 2, api.queries)
+
+	// Results of queries evaluated at now and cached for 5m are served for evaluation times within those 5m.
+	ctx = contextSetPromCacheNow(ctx)
+	ts = time.Unix(1_700_000_400, 0)
+	_, _, err = pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+	pc.cache.Wait()
+
+	value, _, err := pc.Query(ctx, "up", ts.Add(4*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, model.SampleValue(ts.Unix()), value.(model.Vector)[0].Value)
+	require.Equal(t, 3, api.queries)
+
+	_, _, err = pc.Query(ctx, "up", ts.Add(5*time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, 4, api.queries)
+}
+
+func TestPromCacheQueryRangeBucket(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Value: model.SampleValue(ts.Unix()), Timestamp: model.TimeFromUnix(ts.Unix())}}}}
+	}}
+	pc := newTestPromCache(t, api)
+	pc.rangeBucket = time.Minute
+	ctx := contextSetPromCache(context.Background(), time.Hour)
+
+	// A full minute, for near-adjacent ranges within it to share the same buckets.
+	end := time.Unix(1_699_999_980, 0)
+	_, _, err := pc.QueryRange(ctx, "up", prometheusapiv1.Range{Start: end.Add(-time.Hour), End: end, Step: time.Minute})
+	require.NoError(t, err)
+	pc.cache.Wait()
+
+	// A refresh seconds later is served from the cache.
+	later := end.Add(20 * time.Second)
+	value, _, err := pc.QueryRange(ctx, "up", prometheusapiv1.Range{Start: later.Add(-time.Hour), End: later, Step: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, model.SampleValue(end.Unix()), value.(model.Matrix)[0].Values[0].Value)
+	require.Equal(t, 1, api.queries)
+	require.Equal(t,
+		pc.rangeCacheKey("up", prometheusapiv1.Range{Start: end.Add(-time.Hour), End: end, Step: time.Minute}),
+		pc.rangeCacheKey("up", prometheusapiv1.Range{Start: later.Add(-time.Hour), End: later, Step: time.Minute}),
+	)
+
+	// The same range of a different time, e.g. of a past incident, isn't served the cached result.
+	past := end.Add(-24 * time.Hour)
+	value, _, err = pc.QueryRange(ctx, "up", prometheusapiv1.Range{Start: past.Add(-time.Hour), End: past, Step: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, model.SampleValue(past.Unix()), value.(model.Matrix)[0].Values[0].Value)
+	require.Equal(t, 2, api.queries)
+
+	// Neither is the same range with a different step.
+	_, _, err = pc.QueryRange(ctx, "up", prometheusapiv1.Range{Start: end.Add(-time.Hour), End: end, Step: 30 * time.Second})
+	require.NoError(t, err)
+	require.Equal(t, 3, api.queries)
+
+	// Without a bucket ranges are truncated to their step.
+	pc.rangeBucket = 0
+	end = time.Unix(1_699_999_800, 0)
+	require.Equal(t,
+		pc.rangeCacheKey("up", prometheusapiv1.Range{Start: end.Add(-time.Hour), End: end, Step: 5 * time.Minute}),
+		pc.rangeCacheKey("up", prometheusapiv1.Range{Start: end.Add(-time.Hour + 2*time.Minute), End: end.Add(2 * time.Minute), Step: 5 * time.Minute}),
+	)
+}
+
+func TestObjectiveServerStatusCacheDuration(t *testing.T) {
+	objective, api := statusTestObjective()
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: newTestPromCache(t, api),
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	for _, window := range []time.Duration{28 * 24 * time.Hour, time.Hour} {
+		objective.Window = model.Duration(window)
+		server.client = &fakeBackendClient{objectives: []slo.Objective{objective}}
+		api.queried = nil
+
+		ts := time.Unix(1_700_000_000, 0)
+		_, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Time: timestamppb.New(ts)}))
+		require.NoError(t, err)
+		server.promAPI.cache.Wait()
+
+		// Statuses of objectives with longer windows are cached for longer.
+		cacheDuration := instantCache(window)
+		require.Len(t, api.queried, 2)
+		for _, query := range api.queried {
+			ttl, found := server.promAPI.cache.GetTTL(fmt.Sprintf("%d;%s", ts.Round(10*time.Second).Unix(), query))
+			require.True(t, found)
+			require.InDelta(t, cacheDuration.Seconds(), ttl.Seconds(), 5)
+		}
+	}
+}
+
+func TestErrorBudgetGrouping(t *testing.T) {
+	t.Run("ratio", func(t *testing.T) {
+		objective := slo.Objective{
+			Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+				Errors:   slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+				Total:    slo.Metric{Name: "http_requests_total"},
+				Grouping: []string{"job", "handler"},
+			}},
+		}
+
+		objective = errorBudgetGrouping(objective, []*labels.Matcher{
+			labels.MustNewMatcher(labels.MatchEqual, "job", "api"),
+		})
+		require.Equal(t, []string{"handler"}, objective.Indicator.Ratio.Grouping)
+		require.Contains(t, objective.Indicator.Ratio.Errors.LabelMatchers, labels.MustNewMatcher(labels.MatchEqual, "job", "api"))
+		require.Contains(t, objective.Indicator.Ratio.Total.LabelMatchers, labels.MustNewMatcher(labels.MatchEqual, "job", "api"))
+	})
+
+	t.Run("latency", func(t *testing.T) {
+		objective := slo.Objective{
+			Indicator: slo.Indicator{Latency: &slo.LatencyIndicator{
+				Success:  slo.Metric{Name: "http_request_duration_seconds_bucket", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "le", "1")}},
+				Total:    slo.Metric{Name: "http_request_duration_seconds_count"},
+				Grouping: []string{"job", "handler"},
+			}},
+		}
+
+		require.NotPanics(t, func() {
+			objective = errorBudgetGrouping(objective, []*labels.Matcher{
+				labels.MustNewMatcher(labels.MatchEqual, "job", "api"),
+			})
+		})
+		require.Equal(t, []string{"handler"}, objective.Indicator.Latency.Grouping)
+		require.Contains(t, objective.Indicator.Latency.Success.LabelMatchers, labels.MustNewMatcher(labels.MatchEqual, "job", "api"))
+		require.Contains(t, objective.Indicator.Latency.Total.LabelMatchers, labels.MustNewMatcher(labels.MatchEqual, "job", "api"))
+	})
+}
+
+func TestPromCacheDisabled(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Vector{{Value: 1, Timestamp: model.TimeFromUnix(ts.Unix())}}
+	}}
+	pc := &promCache{api: api}
+	ctx := contextSetPromCache(context.Background(), time.Minute)
+	ts := time.Unix(1_700_000_000, 0)
+
+	for i := 0; i < 3; i++ {
+		_, _, err := pc.Query(ctx, "up", ts)
+		require.NoError(t, err)
+		_, _, err = pc.QueryRange(ctx, "up", prometheusapiv1.Range{Start: ts.Add(-time.Hour), End: ts, Step: time.Minute})
+		require.NoError(t, err)
+	}
+	require.Equal(t, 6, api.queries)
+}
+
+func TestPromCacheRequestsMetric(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Value: 1, Timestamp: model.TimeFromUnix(ts.Unix())}}}}
+	}}
+	pc := newTestPromCache(t, api)
+	ctx := contextSetPromCache(context.Background(), time.Minute)
+	ts := time.Unix(1_700_000_000, 0)
+	r := prometheusapiv1.Range{Start: ts.Add(-time.Hour), End: ts, Step: time.Minute}
+
+	// The first query misses and is then served from the cache twice.
+	for i := 0; i < 3; i++ {
+		_, _, err := pc.QueryRange(ctx, "up", r)
+		require.NoError(t, err)
+		pc.cache.Wait()
+	}
+	// Instant queries returning a matrix aren't cached and always miss.
+	for i := 0; i < 2; i++ {
+		_, _, err := pc.Query(ctx, "up", ts)
+		require.NoError(t, err)
+		pc.cache.Wait()
+	}
+
+	require.Equal(t, 2.0, testutil.ToFloat64(pc.cacheRequests.WithLabelValues("query_range", "hit")))
+	require.Equal(t, 1.0, testutil.ToFloat64(pc.cacheRequests.WithLabelValues("query_range", "miss")))
+	require.Equal(t, 0.0, testutil.ToFloat64(pc.cacheRequests.WithLabelValues("query", "hit")))
+	require.Equal(t, 2.0, testutil.ToFloat64(pc.cacheRequests.WithLabelValues("query", "miss")))
+	require.Equal(t, 3, api.queries)
+}
+
+func TestPromCacheQueryDuration(t *testing.T) {
+	api := &fakePrometheusAPI{delay: 10 * time.Millisecond, value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Value: 1, Timestamp: model.TimeFromUnix(ts.Unix())}}}}
+	}}
+	pc := newTestPromCache(t, api)
+	pc.queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "pyrra_prometheus_query_duration_seconds",
+	}, []string{"type"})
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(pc.queryDuration)
+
+	ctx := contextSetPromCache(context.Background(), time.Minute)
+	ts := time.Unix(1_700_000_000, 0)
+	r := prometheusapiv1.Range{Start: ts.Add(-time.Hour), End: ts, Step: time.Minute}
+
+	// Only the first range query is sent to Prometheus, the others are cached.
+	for i := 0; i < 3; i++ {
+		_, _, err := pc.QueryRange(ctx, "up", r)
+		require.NoError(t, err)
+		pc.cache.Wait()
+	}
+	// Instant queries returning a matrix aren't cached.
+	for i := 0; i < 2; i++ {
+		_, _, err := pc.Query(ctx, "up", ts)
+		require.NoError(t, err)
+	}
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	counts := map[string]uint64{}
+	for _, m := range families[0].GetMetric() {
+		counts[m.GetLabel()[0].GetValue()] = m.GetHistogram().GetSampleCount()
+		require.GreaterOrEqual(t, m.GetHistogram().GetSampleSum(), api.delay.Seconds()*float64(m.GetHistogram().GetSampleCount()))
+	}
+	require.Equal(t, map[string]uint64{"query": 2, "query_range": 1}, counts)
+}
+
+func TestPromCacheAge(t *testing.T) {
+	objective, api := statusTestObjective()
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: newTestPromCache(t, api),
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+	_, handler := objectivesv1alpha1connect.NewObjectiveServiceHandler(server, connect.WithInterceptors(newCacheAgeInterceptor()))
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+	client := objectivesv1alpha1connect.NewObjectiveServiceClient(httpServer.Client(), httpServer.URL)
+
+	ts := timestamppb.New(time.Now().Add(-time.Hour))
+	resp, err := client.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Time: ts}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Header().Get("Age"))
+	server.promAPI.cache.Wait()
+
+	// The entries are stored with the time they were queried at, pretend they're older.
+	for _, query := range api.queried {
+		key := fmt.Sprintf("%d;%s", ts.AsTime().Round(10*time.Second).Unix(), query)
+		cached, found := server.promAPI.cache.Get(key)
+		require.True(t, found)
+		entry := cached.(promCacheEntry)
+		entry.stored = entry.stored.Add(-90 * time.Second)
+		server.promAPI.cache.SetWithTTL(key, entry, valueCost(entry.value), time.Minute)
+	}
+	server.promAPI.cache.Wait()
+
+	resp, err = client.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Time: ts}))
+	require.NoError(t, err)
+	require.Equal(t, "90", resp.Header().Get("Age"))
+	require.Equal(t, 2, api.queries)
+
+	// Clients can ask for fresh results, which replace the cached ones.
+	req := connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Time: ts})
+	req.Header().Set("Cache-Control", "max-age=0, no-cache")
+	resp, err = client.GetStatus(context.Background(), req)
+	require.NoError(t, err)
+	require.Empty(t, resp.Header().Get("Age"))
+	require.Equal(t, 4, api.queries)
+	server.promAPI.cache.Wait()
+
+	resp, err = client.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Time: ts}))
+	require.NoError(t, err)
+	require.Equal(t, "0", resp.Header().Get("Age"))
+	require.Equal(t, 4, api.queries)
+}
+
+func TestPrometheusBasicAuth(t *testing.T) {
+	var config promconfig.HTTPClientConfig
+	require.NoError(t, prometheusBasicAuth(&config, "pyrra", "", ""))
+	require.Nil(t, config.BasicAuth)
+
+	require.NoError(t, prometheusBasicAuth(&config, "pyrra", "", "/etc/pyrra/password"))
+	require.Equal(t, &promconfig.BasicAuth{Username: "pyrra", PasswordFile: "/etc/pyrra/password"}, config.BasicAuth)
+
+	config = promconfig.HTTPClientConfig{}
+	require.EqualError(t, prometheusBasicAuth(&config, "", "", "/etc/pyrra/password"), "--prometheus-basic-auth-username is required with a basic auth password")
+	require.EqualError(t, prometheusBasicAuth(&config, "", "secret", ""), "--prometheus-basic-auth-username is required with a basic auth password")
+	require.Nil(t, config.BasicAuth)
+}
+
+func TestPrometheusTLSFlags(t *testing.T) {
+	saved := CLI
+	t.Cleanup(func() { CLI = saved })
+
+	parser, err := kong.New(&CLI)
+	require.NoError(t, err)
+
+	_, err = parser.Parse([]string{
+		"api",
+		"--prometheus-ca-file=ca.pem",
+		"--prometheus-cert-file=cert.pem",
+		"--prometheus-key-file=key.pem",
+		"--prometheus-insecure-skip-verify",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ca.pem", CLI.API.PrometheusCAFile)
+	require.Equal(t, "cert.pem", CLI.API.PrometheusCertFile)
+	require.Equal(t, "key.pem", CLI.API.PrometheusKeyFile)
+	require.True(t, CLI.API.PrometheusInsecureSkipVerify)
+
+	// The deprecated flag still configures the CA file.
+	_, err = parser.Parse([]string{"api", "--tls-client-ca-file=old.pem"})
+	require.NoError(t, err)
+	require.Equal(t, "old.pem", CLI.API.PrometheusCAFile)
+}
+
+func TestPrometheusBearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var clientConfig promconfig.HTTPClientConfig
+	require.NoError(t, prometheusBearerToken(&clientConfig, "secret", ""))
+	require.NoError(t, clientConfig.Validate())
+
+	rt, err := promconfig.NewRoundTripperFromConfig(clientConfig, "prometheus")
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	require.Error(t, prometheusBearerToken(&promconfig.HTTPClientConfig{}, "secret", "/etc/pyrra/token"))
+}
+
+func TestPrometheusOAuth2(t *testing.T) {
+	tokens := 0
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		require.Equal(t, "read write", r.PostForm.Get("scope"))
+		if id, secret, _ := r.BasicAuth(); id != "pyrra" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		tokens++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret"), 0o600))
+
+	var clientConfig promconfig.HTTPClientConfig
+	require.NoError(t, prometheusOAuth2(&clientConfig, "pyrra", secretFile, tokenServer.URL, []string{"read", "write"}))
+	require.NoError(t, clientConfig.Validate())
+
+	rt, err := promconfig.NewRoundTripperFromConfig(clientConfig, "prometheus")
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		_ = resp.Body.Close()
+	}
+	require.Equal(t, 1, tokens) // The token is reused until it expires.
+
+	require.Error(t, prometheusOAuth2(&promconfig.HTTPClientConfig{}, "pyrra", "", tokenServer.URL, nil))
+
+	// OAuth2 can't be combined with other authentication methods.
+	require.NoError(t, prometheusBearerToken(&clientConfig, "secret", ""))
+	require.Error(t, clientConfig.Validate())
+}
+
+func TestThanosClientDownsampling(t *testing.T) {
+	// The requests are recorded in the handler and asserted on in the test's goroutine.
+	var (
+		mu       sync.Mutex
+		requests []url.Values
+		paths    []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests = append(requests, r.PostForm)
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+	}))
+	defer server.Close()
+
+	// Prometheus served with a path prefix.
+	client, err := api.NewClient(api.Config{Address: server.URL + "/prometheus"})
+	require.NoError(t, err)
+	promAPI := prometheusapiv1.NewAPI(&thanosClient{client: client})
+
+	end := time.Now()
+	_, _, err = promAPI.QueryRange(context.Background(), "up", prometheusapiv1.Range{Start: end.Add(-30 * 24 * time.Hour), End: end, Step: time.Hour})
+	require.NoError(t, err)
+	_, _, err = promAPI.QueryRange(context.Background(), "up", prometheusapiv1.Range{Start: end.Add(-8 * 24 * time.Hour), End: end, Step: time.Hour})
+	require.NoError(t, err)
+	_, _, err = promAPI.QueryRange(context.Background(), "up", prometheusapiv1.Range{Start: end.Add(-time.Hour), End: end, Step: time.Minute})
+	require.NoError(t, err)
+	_, _, err = promAPI.Query(context.Background(), "up", end)
+	require.NoError(t, err)
+
+	// A non-standard API path still gets downsampled data.
+	body := url.Values{
+		"query": {"up"},
+		"start": {strconv.FormatInt(end.Add(-30*24*time.Hour).Unix(), 10)},
+		"end":   {strconv.FormatInt(end.Unix(), 10)},
+	}.Encode()
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/custom/query_range", strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, _, err = (&thanosClient{client: client}).Do(context.Background(), req)
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{
+		"/prometheus/api/v1/query_range",
+		"/prometheus/api/v1/query_range",
+		"/prometheus/api/v1/query_range",
+		"/prometheus/api/v1/query",
+		"/custom/query_range",
+	}, paths)
+	require.Equal(t, "1h", requests[0].Get("max_source_resolution"))
+	require.Equal(t, "5m", requests[1].Get("max_source_resolution"))
+	require.False(t, requests[2].Has("max_source_resolution"))
+	require.False(t, requests[3].Has("max_source_resolution"))
+	require.Equal(t, "1h", requests[4].Get("max_source_resolution"))
+	for _, r := range requests[:4] {
+		require.Equal(t, "false", r.Get("partial_response"))
+	}
+}
+
+func TestGzipRoundTripper(t *testing.T) {
+	// The requests are recorded in the handler and asserted on in the test's goroutine.
+	var (
+		mu        sync.Mutex
+		encodings []string
+		requests  []url.Values
+		closeErrs []error
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests = append(requests, r.PostForm)
+		encodings = append(encodings, r.Header.Get("Accept-Encoding"))
+		mu.Unlock()
+
+		body := []byte(`{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"job":"api"},"values":[[1700000000,"1"]]}]}}`)
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			_, _ = w.Write(body)
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write(body)
+		if err := gz.Close(); err != nil {
+			mu.Lock()
+			closeErrs = append(closeErrs, err)
+			mu.Unlock()
+		}
+	}))
+	defer server.Close()
+
+	// The transport of prometheus/common doesn't handle compression itself.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DisableCompression = true
+
+	for _, rt := range []http.RoundTripper{&gzipRoundTripper{next: transport}, transport} {
+		client, err := api.NewClient(api.Config{Address: server.URL, RoundTripper: rt})
+		require.NoError(t, err)
+		// The Thanos client rewrites the request body, which compression must not interfere with.
+		promAPI := prometheusapiv1.NewAPI(newThanosClient(client))
+
+		end := time.Now()
+		value, _, err := promAPI.QueryRange(context.Background(), "up", prometheusapiv1.Range{Start: end.Add(-30 * 24 * time.Hour), End: end, Step: time.Hour})
+		require.NoError(t, err)
+		require.Len(t, value.(model.Matrix), 1)
+		require.Equal(t, model.LabelValue("api"), value.(model.Matrix)[0].Metric["job"])
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Empty(t, closeErrs)
+	require.Equal(t, []string{"gzip", ""}, encodings)
+	for _, r := range requests {
+		require.Equal(t, "false", r.Get("partial_response"))
+		require.Equal(t, "1h", r.Get("max_source_resolution"))
+	}
+}
+
+func TestPrometheusUserAgent(t *testing.T) {
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	for _, userAgent := range []string{"", "pyrra-staging"} {
+		rt, err := promconfig.NewRoundTripperFromConfig(promconfig.HTTPClientConfig{}, "prometheus", promconfig.WithUserAgent(prometheusUserAgent(userAgent)))
+		require.NoError(t, err)
+		resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+		require.NoError(t, err)
+		_ = resp.Body.Close()
+	}
+	require.Equal(t, []string{"pyrra/" + version, "pyrra-staging"}, userAgents)
+}
+
+func TestPrometheusTenant(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("X-Scope-OrgID") != "team-a" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var clientConfig promconfig.HTTPClientConfig
+	require.NoError(t, prometheusBearerToken(&clientConfig, "secret", ""))
+	require.NoError(t, prometheusTenant(&clientConfig, "X-Scope-OrgID", "team-a"))
+	require.NoError(t, clientConfig.Validate())
+
+	rt, err := promconfig.NewRoundTripperFromConfig(clientConfig, "prometheus")
+	require.NoError(t, err)
+	resp, err := (&http.Client{Transport: rt}).Get(server.URL)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_ = resp.Body.Close()
+
+	clientConfig = promconfig.HTTPClientConfig{}
+	require.NoError(t, prometheusTenant(&clientConfig, "X-Scope-OrgID", ""))
+	require.Nil(t, clientConfig.HTTPHeaders)
+
+	require.Error(t, prometheusTenant(&clientConfig, "", "team-a"))
+
+	// Headers set by the client itself can't be overwritten.
+	require.NoError(t, prometheusTenant(&clientConfig, "Authorization", "team-a"))
+	require.Error(t, clientConfig.Validate())
+}
+
+func TestPromCacheTimeout(t *testing.T) {
+	api := &fakePrometheusAPI{delay: time.Second, value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{}
+	}}
+	pc := &promCache{api: api, timeout: 10 * time.Millisecond}
+
+	_, _, err := pc.Query(context.Background(), "up", time.Now())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, connect.CodeDeadlineExceeded, queryErrorCode(err))
+
+	_, _, err = pc.QueryRange(context.Background(), "up", prometheusapiv1.Range{Start: time.Now().Add(-time.Hour), End: time.Now(), Step: time.Minute})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, connect.CodeDeadlineExceeded, queryErrorCode(err))
+
+	require.Equal(t, connect.CodeInternal, queryErrorCode(errors.New("bad_data")))
+}
+
+func TestPromCacheRetry(t *testing.T) {
+	serverErr := &prometheusapiv1.Error{Type: prometheusapiv1.ErrServer, Msg: "server error: 503"}
+	newAPI := func(errs ...error) *fakePrometheusAPI {
+		return &fakePrometheusAPI{errs: errs, value: func(_ string, _ time.Time) model.Value {
+			return model.Vector{{Value: 1}}
+		}}
+	}
+
+	t.Run("transient", func(t *testing.T) {
+		api := newAPI(serverErr, &url.Error{Op: "Post", URL: "http://prometheus", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}})
+		pc := &promCache{api: api, retries: 2, retryBackoff: time.Millisecond}
+
+		value, _, err := pc.Query(context.Background(), "up", time.Now())
+		require.NoError(t, err)
+		require.Equal(t, model.Vector{{Value: 1}}, value)
+		require.Equal(t, 3, api.queries)
+
+		api = newAPI(serverErr, serverErr)
+		pc.api = api
+		_, _, err = pc.QueryRange(context.Background(), "up", prometheusapiv1.Range{Start: time.Now().Add(-time.Hour), End: time.Now(), Step: time.Minute})
+		require.NoError(t, err)
+		require.Equal(t, 3, api.queries)
+	})
+	t.Run("exhausted", func(t *testing.T) {
+		api := newAPI(serverErr, serverErr, serverErr)
+		pc := &promCache{api: api, retries: 2, retryBackoff: time.Millisecond}
+
+		_, _, err := pc.Query(context.Background(), "up", time.Now())
+		require.ErrorIs(t, err, serverErr)
+		require.Equal(t, 3, api.queries)
+	})
+	t.Run("client", func(t *testing.T) {
+		api := newAPI(&prometheusapiv1.Error{Type: prometheusapiv1.ErrBadData, Msg: "parse error"})
+		pc := &promCache{api: api, retries: 2, retryBackoff: time.Millisecond}
+
+		_, _, err := pc.Query(context.Background(), "up{", time.Now())
+		require.Error(t, err)
+		require.Equal(t, 1, api.queries)
+	})
+	t.Run("canceled", func(t *testing.T) {
+		api := newAPI(context.Canceled)
+		pc := &promCache{api: api, retries: 2, retryBackoff: time.Millisecond}
+
+		_, _, err := pc.Query(context.Background(), "up", time.Now())
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, api.queries)
+	})
+	t.Run("deadline", func(t *testing.T) {
+		api := newAPI(serverErr, serverErr)
+		pc := &promCache{api: api, retries: 2, retryBackoff: time.Hour}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		_, _, err := pc.Query(ctx, "up", time.Now())
+		require.ErrorIs(t, err, serverErr)
+		require.Equal(t, 1, api.queries)
+	})
+}
+
+func TestPromCacheMaxConcurrentQueries(t *testing.T) {
+	api := &fakePrometheusAPI{delay: 10 * time.Millisecond, value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{}
+	}}
+	pc := &promCache{api: api, queries: make(chan struct{}, 3)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _, err := pc.Query(context.Background(), fmt.Sprintf("up%d", i), time.Now())
+			require.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, _, err := pc.QueryRange(context.Background(), fmt.Sprintf("up%d", i), prometheusapiv1.Range{Start: time.Now().Add(-time.Hour), End: time.Now(), Step: time.Minute})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 40, api.queries)
+	require.Equal(t, 3, api.maxInflight)
+
+	// Queries waiting for a free slot give up once their context is done.
+	pc.queries <- struct{}{}
+	pc.queries <- struct{}{}
+	pc.queries <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := pc.Query(ctx, "up", time.Now())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 40, api.queries)
+}
+
+func TestUIFileServer(t *testing.T) {
+	handler, err := uiFileServer(fstest.MapFS{
+		"index.html":                 {Data: []byte("<html></html>")},
+		"favicon.ico":                {Data: []byte("icon")},
+		"static/js/main.1a2b3c4d.js": {Data: []byte("console.log('pyrra')")},
+	})
+	require.NoError(t, err)
+	handler = http.StripPrefix("/pyrra", handler)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pyrra/static/js/main.1a2b3c4d.js", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "public, max-age=31536000, immutable", rec.Header().Get("Cache-Control"))
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/pyrra/static/js/main.1a2b3c4d.js", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusNotModified, rec.Code)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pyrra/favicon.ico", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+	require.NotEmpty(t, rec.Header().Get("ETag"))
+	require.NotEqual(t, etag, rec.Header().Get("ETag"))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pyrra/missing.js", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Empty(t, rec.Header().Get("Cache-Control"))
+}
+
+func TestRoutePrefixes(t *testing.T) {
+	for _, tc := range []struct {
+		routePrefix, uiRoutePrefix     string
+		expectedRoute, expectedUIRoute string
+	}{
+		{routePrefix: "", uiRoutePrefix: "", expectedRoute: "/", expectedUIRoute: "/"},
+		{routePrefix: "/", uiRoutePrefix: "", expectedRoute: "/", expectedUIRoute: "/"},
+		{routePrefix: "/pyrra", uiRoutePrefix: "", expectedRoute: "/pyrra", expectedUIRoute: "/pyrra"},
+		{routePrefix: "/pyrra/", uiRoutePrefix: "", expectedRoute: "/pyrra", expectedUIRoute: "/pyrra"},
+		{routePrefix: "pyrra", uiRoutePrefix: "", expectedRoute: "/pyrra", expectedUIRoute: "/pyrra"},
+		{routePrefix: "", uiRoutePrefix: "/pyrra/", expectedRoute: "/", expectedUIRoute: "/pyrra"},
+		{routePrefix: "/internal/", uiRoutePrefix: "pyrra", expectedRoute: "/internal", expectedUIRoute: "/pyrra"},
+	} {
+		routePrefix, uiRoutePrefix := routePrefixes(tc.routePrefix, tc.uiRoutePrefix)
+		require.Equal(t, tc.expectedRoute, routePrefix, tc)
+		require.Equal(t, tc.expectedUIRoute, uiRoutePrefix, tc)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limited := prometheus.NewCounter(prometheus.CounterOpts{Name: "limited"})
+	limiter := newRateLimiter(rate.Limit(1), 2, limited)
+	handler := limiter.middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	request := func(remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/status", nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// The burst is served, then the client is limited.
+	require.Equal(t, http.StatusOK, request("10.0.0.1:1234").Code)
+	require.Equal(t, http.StatusOK, request("10.0.0.1:1235").Code)
+	rec := request("10.0.0.1:1236")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Equal(t, 1.0, testutil.ToFloat64(limited))
+
+	// Other clients have their own limit.
+	require.Equal(t, http.StatusOK, request("10.0.0.2:1234").Code)
+
+	// Idle clients are forgotten.
+	limiter.limiter("10.0.0.3", time.Now().Add(2*time.Minute))
+	require.Len(t, limiter.clients, 1)
+}
+
+func TestUIHandler(t *testing.T) {
+	build := fstest.MapFS{
+		"index.html":                 {Data: []byte("{{.PathPrefix}} {{.APIBasepath}} {{.PrometheusURL}} {{.Title}} {{.LogoURL}}")},
+		"static/js/main.1a2b3c4d.js": {Data: []byte("console.log('pyrra')")},
+	}
+
+	for _, tc := range []struct {
+		name                       string
+		routePrefix, uiRoutePrefix string
+		index, files               []string
+		missing                    string
+	}{{
+		name:          "root",
+		routePrefix:   "/",
+		uiRoutePrefix: "/",
+		index:         []string{"/", "/objectives", "/objectives/"},
+		files:         []string{"/static/js/main.1a2b3c4d.js"},
+		missing:       "/static/js/missing.js",
+	}, {
+		name:          "prefix",
+		routePrefix:   "/pyrra",
+		uiRoutePrefix: "/pyrra",
+		index:         []string{"/pyrra", "/pyrra/", "/pyrra/objectives", "/pyrra/objectives/"},
+		files:         []string{"/pyrra/static/js/main.1a2b3c4d.js"},
+		missing:       "/pyrra/static/js/missing.js",
+	}, {
+		name:          "stripped by proxy",
+		routePrefix:   "/",
+		uiRoutePrefix: "/pyrra",
+		index:         []string{"/", "/objectives/"},
+		files:         []string{"/static/js/main.1a2b3c4d.js"},
+		missing:       "/pyrra/static/js/main.1a2b3c4d.js",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			handler, err := uiHandler(log.NewNopLogger(), build, tc.routePrefix, tc.uiRoutePrefix, "http://prometheus:9090", "Example SLOs", "https://example.com/logo.svg")
+			require.NoError(t, err)
+
+			for _, path := range tc.index {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+				require.Equal(t, http.StatusOK, rec.Code, path)
+				require.Equal(t, "no-cache", rec.Header().Get("Cache-Control"), path)
+				require.Equal(t, tc.uiRoutePrefix+" "+tc.uiRoutePrefix+" http://prometheus:9090 Example SLOs https://example.com/logo.svg", rec.Body.String(), path)
+			}
+			for _, path := range tc.files {
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+				require.Equal(t, http.StatusOK, rec.Code, path)
+				require.Equal(t, "console.log('pyrra')", rec.Body.String(), path)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.missing, nil))
+			require.Equal(t, http.StatusNotFound, rec.Code)
+		})
+	}
+
+	// Prometheus served with a path prefix and a trailing slash.
+	handler, err := uiHandler(log.NewNopLogger(), build, "/", "/", "http://gateway/prometheus/", "Pyrra", "")
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	require.Equal(t, "/ / http://gateway/prometheus Pyrra ", rec.Body.String())
+}
+
+// writeTestCert writes a self-signed certificate for the common name and its key to the files.
+func writeTestCert(t *testing.T, certFile, keyFile, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(cryptorand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+
+	_, err := newCertReloader(certFile, keyFile)
+	require.Error(t, err)
+
+	writeTestCert(t, certFile, keyFile, "first")
+	certs, err := newCertReloader(certFile, keyFile)
+	require.NoError(t, err)
+	commonName := func() string {
+		cert, err := certs.GetCertificate(nil)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(cert.Certificate[0])
+		require.NoError(t, err)
+		return leaf.Subject.CommonName
+	}
+	require.Equal(t, "first", commonName())
+
+	writeTestCert(t, certFile, keyFile, "rotated")
+	require.Equal(t, "first", commonName())
+	require.NoError(t, certs.reload())
+	require.Equal(t, "rotated", commonName())
+
+	// The previous certificate is kept if the files are broken.
+	require.NoError(t, os.WriteFile(keyFile, []byte("broken"), 0o600))
+	require.Error(t, certs.reload())
+	require.Equal(t, "rotated", commonName())
+}
+
+func TestStatusHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	statusHandler(statusResponse{
+		Version:       "v0.8.0",
+		Commit:        "abc123",
+		GoVersion:     "go1.23.0",
+		PrometheusURL: "http://localhost:9090",
+		BackendURL:    "http://localhost:9444",
+		CacheEnabled:  true,
+	})(rec, httptest.NewRequest(http.MethodGet, "/api/v1/status", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	require.JSONEq(t, `{
+		"version": "v0.8.0",
+		"commit": "abc123",
+		"goVersion": "go1.23.0",
+		"prometheusURL": "http://localhost:9090",
+		"backendURL": "http://localhost:9444",
+		"cacheEnabled": true
+	}`, rec.Body.String())
+}
+
+func TestHealthHandlers(t *testing.T) {
+	rec := httptest.NewRecorder()
+	healthzHandler(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{{Value: 1}}
+	}}
+	pc := newTestPromCache(t, api)
+	for i := 0; i < 2; i++ {
+		rec = httptest.NewRecorder()
+		readyzHandler(log.NewNopLogger(), pc)(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.JSONEq(t, `{"status":"ok","prometheus":"ok"}`, rec.Body.String())
+	}
+	// Readiness probes query Prometheus every time without counting as cache misses.
+	require.Equal(t, 2, api.queries)
+	require.Equal(t, 0.0, testutil.ToFloat64(pc.cacheRequests.WithLabelValues("query", "miss")))
+
+	api.delay = time.Second
+	rec = httptest.NewRecorder()
+	readyzHandler(log.NewNopLogger(), &promCache{api: api, timeout: time.Millisecond})(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	require.Contains(t, rec.Body.String(), `"status":"unavailable"`)
+}
+
+func TestObjectiveServerGetAlertsQuery(t *testing.T) {
+	objective := slo.Objective{
+		Labels: labels.FromStrings(labels.MetricName, "http-errors", "namespace", "monitoring"),
+		Target: 0.99,
+		Window: model.Duration(28 * 24 * time.Hour),
+		Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+			Errors: slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+			Total:  slo.Metric{Name: "http_requests_total"},
+		}},
+	}
+
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	_, err := server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []string{`ALERTS{slo="http-errors"}`}, api.queried)
+
+	api.queried = nil
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective, objective}}
+	_, err = server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, []string{`ALERTS{slo=~".+"}`}, api.queried)
+}
+
+func TestObjectiveServerGetAlertsExceeding(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(query string, _ time.Time) model.Value {
+		if strings.HasPrefix(query, "ALERTS") {
+			return model.Vector{}
+		}
+		// All windows burn at 0.1, exceeding every threshold but the one of factor 14.
+		return model.Vector{{Metric: model.Metric{}, Value: 0.1}}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{
+		Inactive: true,
+		Current:  true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Alerts, 4)
+	for _, a := range resp.Msg.Alerts {
+		require.InDelta(t, a.Factor*(1-objective.Target), a.Threshold, 1e-9)
+		require.Equal(t, proto.Float64(0.1), a.Short.Current)
+		require.Equal(t, proto.Float64(0.1), a.Long.Current)
+		require.Equal(t, a.Factor != 14, a.Exceeding, a.Factor)
+	}
+
+	// Without current burn rates, no alert is exceeding.
+	resp, err = server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{
+		Inactive: true,
+	}))
+	require.NoError(t, err)
+	for _, a := range resp.Msg.Alerts {
+		require.False(t, a.Exceeding)
+	}
+}
+
+// blockingPrometheusAPI answers ALERTS queries immediately and blocks all other queries until they're canceled.
+type blockingPrometheusAPI struct {
+	started  chan string
+	canceled atomic.Int64
+}
+
+func (b *blockingPrometheusAPI) Query(ctx context.Context, query string, _ time.Time, _ ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
+	if strings.HasPrefix(query, "ALERTS") {
+		return model.Vector{}, nil, nil
+	}
+	b.started <- query
+	<-ctx.Done()
+	b.canceled.Add(1)
+	return nil, nil, ctx.Err()
+}
+
+func (b *blockingPrometheusAPI) QueryRange(ctx context.Context, _ string, _ prometheusapiv1.Range, _ ...prometheusapiv1.Option) (model.Value, prometheusapiv1.Warnings, error) {
+	<-ctx.Done()
+	return nil, nil, ctx.Err()
+}
+
+func TestObjectiveServerGetAlertsCanceled(t *testing.T) {
+	objective, _ := statusTestObjective()
+	other := objective
+	other.Labels = labels.FromStrings(labels.MetricName, "http-latency")
+	windows := len(burnrateWindows(objective))
+
+	api := &blockingPrometheusAPI{started: make(chan string, 2*windows)}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective, other}},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := server.GetAlerts(ctx, connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{Current: true}))
+		errs <- err
+	}()
+
+	// Cancel once all burn rate queries of the first objective are in flight.
+	for range windows {
+		<-api.started
+	}
+	cancel()
+
+	select {
+	case err := <-errs:
+		require.Equal(t, connect.CodeCanceled, connect.CodeOf(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("GetAlerts didn't return after the request was canceled")
+	}
+	// The in-flight queries were canceled and the second objective's burn rates were never queried.
+	require.Equal(t, int64(windows), api.canceled.Load())
+	require.Empty(t, api.started)
+}
+
+func TestObjectiveServerGetSnapshot(t *testing.T) {
+	objective, status := statusTestObjective()
+	queryErrorBudget := objective.QueryErrorBudget()
+	var (
+		mu         sync.Mutex
+		timestamps []time.Time
+	)
+	api := &fakePrometheusAPI{value: func(query string, ts time.Time) model.Value {
+		if query != queryErrorBudget {
+			mu.Lock()
+			timestamps = append(timestamps, ts)
+			mu.Unlock()
+		}
+		switch {
+		case query == queryErrorBudget:
+			return model.Matrix{{Metric: model.Metric{}, Values: []model.SamplePair{
+				{Timestamp: model.TimeFromUnix(ts.Add(-time.Minute).Unix()), Value: 0.5},
+				{Timestamp: model.TimeFromUnix(ts.Unix()), Value: 0.4},
+			}}}
+		case strings.HasPrefix(query, "ALERTS"):
+			return model.Vector{}
+		case strings.Contains(query, "burnrate"):
+			return model.Vector{{Metric: model.Metric{}, Value: 0.001}}
+		default:
+			return status.value(query, ts)
+		}
+	}}
+	server := &objectiveServer{
+		logger:      log.NewNopLogger(),
+		promAPI:     &promCache{api: api},
+		client:      &fakeBackendClient{objectives: []slo.Objective{objective}},
+		queryOffset: time.Minute,
+	}
+
+	resp, err := server.GetSnapshot(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSnapshotRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, objective.Labels, objectivesv1alpha1.ToInternal(resp.Msg.Objective).Labels)
+	require.NotNil(t, resp.Msg.Time)
+	require.Len(t, resp.Msg.Status, 1)
+	require.Equal(t, 0.99, resp.Msg.Status[0].Availability.Percentage)
+	require.Equal(t, queryErrorBudget, resp.Msg.ErrorBudget.Query)
+	require.Len(t, resp.Msg.ErrorBudget.Series, 2)
+	// All alerts are included while inactive, with their windows' current burn rates.
+	require.Len(t, resp.Msg.Alerts, len(objective.Windows()))
+	for _, alert := range resp.Msg.Alerts {
+		require.Equal(t, objectivesv1alpha1.Alert_inactive, alert.State)
+		require.Equal(t, proto.Float64(0.001), alert.Short.Current)
+		require.Equal(t, proto.Float64(0.001), alert.Long.Current)
+	}
+	// The status and alerts are all evaluated at the snapshot's time.
+	require.NotEmpty(t, timestamps)
+	for _, ts := range timestamps {
+		require.True(t, resp.Msg.Time.AsTime().Equal(ts), "%s != %s", ts, resp.Msg.Time.AsTime())
+	}
+
+	// A failing part fails the whole snapshot.
+	api.errs = []error{errors.New("connection refused")}
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective}}
+	_, err = server.GetSnapshot(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSnapshotRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.Error(t, err)
+}
+
+func TestObjectiveServerGetAlertsBurnrateError(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(query string, _ time.Time) model.Value {
+		switch {
+		case strings.HasPrefix(query, "ALERTS"):
+			return model.Vector{}
+		case strings.Contains(query, "burnrate1h"):
+			return model.Matrix{}
+		case strings.Contains(query, "burnrate5m"):
+			// Without any data the burn rate is unknown but didn't fail.
+			return model.Vector{}
+		default:
+			return model.Vector{{Metric: model.Metric{}, Value: 0.1}}
+		}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{
+		Inactive: true,
+		Current:  true,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Alerts, 4)
+	for _, a := range resp.Msg.Alerts {
+		if a.Factor != 14 {
+			require.Empty(t, a.Short.Error)
+			require.Empty(t, a.Long.Error)
+			require.Equal(t, proto.Float64(0.1), a.Short.Current)
+			continue
+		}
+		require.Nil(t, a.Short.Current)
+		require.Empty(t, a.Short.Error)
+		require.Nil(t, a.Long.Current)
+		// The error of Prometheus isn't returned, it might reveal details about its setup.
+		require.Equal(t, "failed to query the current burn rate", a.Long.Error)
+	}
+}
+
+// statusTestObjective returns a ratio objective and a fake Prometheus API returning 100 requests of which 1 errored.
+func statusTestObjective() (slo.Objective, *fakePrometheusAPI) {
+	objective := slo.Objective{
+		Labels: labels.FromStrings(labels.MetricName, "http-errors"),
+		Target: 0.99,
+		Window: model.Duration(28 * 24 * time.Hour),
+		Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+			Errors: slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+			Total:  slo.Metric{Name: "http_requests_total"},
+		}},
+	}
+	queryErrors := objective.QueryErrors(objective.Window)
+
+	return objective, &fakePrometheusAPI{value: func(query string, _ time.Time) model.Value {
+		if query == queryErrors {
+			return model.Vector{{Metric: model.Metric{"job": "api"}, Value: 1}}
+		}
+		return model.Vector{{Metric: model.Metric{"job": "api"}, Value: 100}}
+	}}
+}
+
+func TestObjectiveServerGetStatus(t *testing.T) {
+	objective, api := statusTestObjective()
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Status, 1)
+	require.Equal(t, map[string]string{"job": "api"}, resp.Msg.Status[0].Labels)
+	require.Equal(t, 100.0, resp.Msg.Status[0].Availability.Total)
+	require.Equal(t, 1.0, resp.Msg.Status[0].Availability.Errors)
+	require.InDelta(t, 0.99, resp.Msg.Status[0].Availability.Percentage, 1e-9)
+	require.InDelta(t, 0, resp.Msg.Status[0].Budget.Remaining, 1e-9)
+	require.Equal(t, 6*time.Hour+43*time.Minute+12*time.Second, resp.Msg.Status[0].Budget.Allowed.AsDuration())
+	require.Equal(t, 2, api.queries)
+
+	// Past statuses are evaluated at the requested time.
+	past := time.Now().Add(-24 * time.Hour)
+	var (
+		mu        sync.Mutex
+		evaluated []time.Time
+	)
+	value := api.value
+	api.value = func(query string, ts time.Time) model.Value {
+		// The queries of a status run concurrently.
+		mu.Lock()
+		evaluated = append(evaluated, ts)
+		mu.Unlock()
+		return value(query, ts)
+	}
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr: `{__name__="http-errors"}`,
+		Time: timestamppb.New(past),
+	}))
+	require.NoError(t, err)
+	require.Len(t, evaluated, 2)
+	for _, ts := range evaluated {
+		require.True(t, past.Equal(ts))
+	}
+
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr: `{__name__="http-errors"}`,
+		Time: timestamppb.New(time.Now().Add(time.Hour)),
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Len(t, evaluated, 2)
+
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr:      `{__name__="http-errors"}`,
+		Precision: 16,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Len(t, evaluated, 2)
+}
+
+func TestObjectiveServerGetStatusUnexpectedValue(t *testing.T) {
+	objective, _ := statusTestObjective()
+
+	for _, tc := range []struct {
+		name  string
+		value model.Value
+		code  connect.Code
+	}{
+		{name: "scalar", value: &model.Scalar{Value: 100}, code: connect.CodeAborted},
+		{name: "string", value: &model.String{Value: "100"}, code: connect.CodeAborted},
+		{name: "matrix", value: model.Matrix{}, code: connect.CodeInternal},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &objectiveServer{
+				logger: log.NewNopLogger(),
+				promAPI: &promCache{api: &fakePrometheusAPI{value: func(string, time.Time) model.Value {
+					return tc.value
+				}}},
+				client: &fakeBackendClient{objectives: []slo.Objective{objective}},
+			}
+
+			_, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+				Expr: `{__name__="http-errors"}`,
+			}))
+			require.Equal(t, tc.code, connect.CodeOf(err))
+			require.Contains(t, err.Error(), "expected vector, got "+tc.name)
+			if tc.code == connect.CodeAborted {
+				require.Contains(t, err.Error(), "the recording rule must produce an instant vector")
+			}
+		})
+	}
+}
+
+func TestRoundStatuses(t *testing.T) {
+	status := func() []*objectivesv1alpha1.ObjectiveStatus {
+		return []*objectivesv1alpha1.ObjectiveStatus{{
+			Availability: &objectivesv1alpha1.Availability{Percentage: 0.9994999999, Total: 1234.5678},
+			Budget:       &objectivesv1alpha1.Budget{Remaining: 0.123456789, Total: 0.001},
+		}}
+	}
+
+	statuses := status()
+	roundStatuses(statuses, 0)
+	require.Equal(t, status(), statuses)
+
+	roundStatuses(statuses, 4)
+	require.Equal(t, 0.9995, statuses[0].Availability.Percentage)
+	require.Equal(t, 0.1235, statuses[0].Budget.Remaining)
+	// Only the percentage and remaining budget are rounded.
+	require.Equal(t, 1234.5678, statuses[0].Availability.Total)
+	require.Equal(t, 0.001, statuses[0].Budget.Total)
+}
+
+func TestObjectiveServerGetBudgetExhaustion(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+	api := &fakePrometheusAPI{value: func(query string, _ time.Time) model.Value {
+		switch {
+		case strings.Contains(query, "burnrate"):
+			// The recording rules have more labels than the grouping labels.
+			return model.Vector{
+				{Metric: model.Metric{labels.MetricName: "http_requests:burnrate1h", "slo": "http-errors", "job": "api"}, Value: 0.02},
+				{Metric: model.Metric{labels.MetricName: "http_requests:burnrate1h", "slo": "http-errors", "job": "web"}, Value: 0},
+			}
+		case strings.Contains(query, `code=~"5.."`):
+			return model.Vector{
+				{Metric: model.Metric{"job": "api"}, Value: 5},
+				{Metric: model.Metric{"job": "web"}, Value: 5},
+				{Metric: model.Metric{"job": "idle"}, Value: 0},
+			}
+		default:
+			return model.Vector{
+				{Metric: model.Metric{"job": "api"}, Value: 1000},
+				{Metric: model.Metric{"job": "web"}, Value: 1000},
+				{Metric: model.Metric{"job": "idle"}, Value: 0},
+			}
+		}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	before := time.Now()
+	resp, err := server.GetBudgetExhaustion(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetBudgetExhaustionRequest{}))
+	require.NoError(t, err)
+	// The idle job without any traffic is skipped like for statuses.
+	require.Len(t, resp.Msg.Exhaustion, 2)
+
+	exhaustion := map[string]*objectivesv1alpha1.BudgetExhaustion{}
+	for _, e := range resp.Msg.Exhaustion {
+		exhaustion[e.Labels["job"]] = e
+	}
+
+	// Half of the budget is left and burning at twice the rate lasting the whole window.
+	burning := exhaustion["api"]
+	require.InDelta(t, 0.5, burning.Remaining, 1e-9)
+	require.Equal(t, time.Hour, burning.Burnrate.Window.AsDuration())
+	require.Equal(t, proto.Float64(0.02), burning.Burnrate.Current)
+	require.NotNil(t, burning.Exhausted)
+	require.WithinDuration(t, before.Add(7*24*time.Hour), burning.Exhausted.AsTime(), time.Minute)
+
+	notBurning := exhaustion["web"]
+	require.InDelta(t, 0.5, notBurning.Remaining, 1e-9)
+	require.Equal(t, proto.Float64(0.0), notBurning.Burnrate.Current)
+	require.Nil(t, notBurning.Exhausted)
+
+	_, err = server.GetBudgetExhaustion(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetBudgetExhaustionRequest{Grouping: `{code="500"}`}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestObjectiveServerGetGroupingValues(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+	api := &fakePrometheusAPI{value: func(string, time.Time) model.Value {
+		return model.Vector{
+			{Metric: model.Metric{"job": "web"}, Value: 3},
+			{Metric: model.Metric{"job": "api"}, Value: 2},
+			{Metric: model.Metric{}, Value: 1},
+		}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetGroupingValues(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetGroupingValuesRequest{Label: "job"}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"api", "web"}, resp.Msg.Values)
+	require.Equal(t, []string{`count by (job) (http_requests_total)`}, api.queried)
+
+	api.value = func(string, time.Time) model.Value { return model.Vector{} }
+	resp, err = server.GetGroupingValues(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetGroupingValuesRequest{Label: "job"}))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Values)
+
+	for _, label := range []string{"", "handler"} {
+		_, err = server.GetGroupingValues(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetGroupingValuesRequest{Label: label}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), label)
+	}
+	require.Len(t, api.queried, 2)
+}
+
+func TestBudgetExhausted(t *testing.T) {
+	objective := slo.Objective{Target: 0.99, Window: model.Duration(28 * 24 * time.Hour)}
+	ts := time.Unix(1700000000, 0)
+
+	exhausted, burning := budgetExhausted(objective, ts, 1, 0.01)
+	require.True(t, burning)
+	require.WithinDuration(t, ts.Add(28*24*time.Hour), exhausted, time.Second)
+
+	exhausted, burning = budgetExhausted(objective, ts, -0.5, 0.01)
+	require.True(t, burning)
+	require.Equal(t, ts, exhausted)
+
+	for _, burnrate := range []float64{0, -1, math.NaN(), 1e-300} {
+		_, burning = budgetExhausted(objective, ts, 1, burnrate)
+		require.False(t, burning, burnrate)
+	}
+}
+
+func TestStatusTime(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	ts, err := statusTime(nil, now, 0)
+	require.NoError(t, err)
+	require.Equal(t, now, ts)
+
+	// The offset only shifts the default time, explicit times are evaluated as requested.
+	ts, err = statusTime(nil, now, 5*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-5*time.Minute), ts)
+	ts, err = statusTime(timestamppb.New(now), now, 5*time.Minute)
+	require.NoError(t, err)
+	require.True(t, now.Equal(ts))
+
+	ts, err = statusTime(timestamppb.New(now.Add(-time.Hour)), now, 0)
+	require.NoError(t, err)
+	require.True(t, now.Add(-time.Hour).Equal(ts))
+
+	// Small clock skew is allowed.
+	ts, err = statusTime(timestamppb.New(now.Add(30*time.Second)), now, 0)
+	require.NoError(t, err)
+	require.True(t, now.Add(30*time.Second).Equal(ts))
+
+	_, err = statusTime(timestamppb.New(now.Add(2*time.Minute)), now, 0)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = statusTime(&timestamppb.Timestamp{Nanos: -1}, now, 0)
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestObjectiveServerListObjectiveStatuses(t *testing.T) {
+	objective, api := statusTestObjective()
+	broken := objective
+	broken.Labels = labels.FromStrings(labels.MetricName, "broken")
+	broken.Indicator = slo.Indicator{Ratio: &slo.RatioIndicator{
+		Errors: slo.Metric{Name: "broken_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+		Total:  slo.Metric{Name: "broken_total"},
+	}}
+	value := api.value
+	api.value = func(query string, ts time.Time) model.Value {
+		if strings.Contains(query, `slo="broken"`) {
+			return model.Matrix{}
+		}
+		return value(query, ts)
+	}
+
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective, broken}},
+	}
+
+	resp, err := server.ListObjectiveStatuses(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListObjectiveStatusesRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Objectives, 2)
+	require.Equal(t, 4, api.queries)
+
+	ok := resp.Msg.Objectives[0]
+	require.Equal(t, map[string]string{labels.MetricName: "http-errors"}, ok.Labels)
+	require.Empty(t, ok.Error)
+	require.Len(t, ok.Status, 1)
+	require.InDelta(t, 0.99, ok.Status[0].Availability.Percentage, 1e-9)
+
+	failed := resp.Msg.Objectives[1]
+	require.Equal(t, map[string]string{labels.MetricName: "broken"}, failed.Labels)
+	require.Empty(t, failed.Status)
+	require.Contains(t, failed.Error, "expected vector")
+
+	_, err = server.ListObjectiveStatuses(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListObjectiveStatusesRequest{Expr: "{"}))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
+func TestObjectiveServerListObjectiveStatusesZeroTraffic(t *testing.T) {
+	objective, api := statusTestObjective()
+	idle := objective
+	idle.Labels = labels.FromStrings(labels.MetricName, "idle")
+	broken := objective
+	broken.Labels = labels.FromStrings(labels.MetricName, "broken")
+	value := api.value
+	api.value = func(query string, ts time.Time) model.Value {
+		if strings.Contains(query, `slo="idle"`) {
+			return model.Vector{}
+		}
+		if strings.Contains(query, `slo="broken"`) {
+			return model.Matrix{}
+		}
+		return value(query, ts)
+	}
+
+	zeroTraffic := prometheus.NewGauge(prometheus.GaugeOpts{Name: "pyrra_objectives_zero_traffic"})
+	server := &objectiveServer{
+		logger:      log.NewNopLogger(),
+		promAPI:     &promCache{api: api},
+		client:      &fakeBackendClient{objectives: []slo.Objective{objective, idle, broken}},
+		zeroTraffic: zeroTraffic,
+	}
+
+	resp, err := server.ListObjectiveStatuses(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListObjectiveStatusesRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Objectives, 3)
+	require.Empty(t, resp.Msg.Objectives[1].Status)
+	require.Empty(t, resp.Msg.Objectives[1].Error)
+	// Objectives failing to query aren't counted, their traffic is unknown.
+	require.Equal(t, 1.0, testutil.ToFloat64(zeroTraffic))
+
+	// Filtered lists don't update the gauge.
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective}}
+	_, err = server.ListObjectiveStatuses(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListObjectiveStatusesRequest{Expr: `{__name__="http-errors"}`}))
+	require.NoError(t, err)
+	require.Equal(t, 1.0, testutil.ToFloat64(zeroTraffic))
+
+	// Lists missing the objectives of failed backends don't update the gauge either.
+	server.client = newMultiBackendClient(log.NewNopLogger(), []*url.URL{{Host: "filesystem:9444"}, {Host: "down:9444"}}, []objectivesv1alpha1connect.ObjectiveBackendServiceClient{
+		&fakeBackendClient{objectives: []slo.Objective{objective}},
+		&fakeBackendClient{err: connect.NewError(connect.CodeUnavailable, errors.New("connection refused"))},
+	})
+	resp, err = server.ListObjectiveStatuses(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListObjectiveStatusesRequest{}))
+	require.NoError(t, err)
+	require.True(t, partialList(resp.Header()))
+	require.Equal(t, 1.0, testutil.ToFloat64(zeroTraffic))
+
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective}}
+	_, err = server.ListObjectiveStatuses(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListObjectiveStatusesRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, 0.0, testutil.ToFloat64(zeroTraffic))
+}
+
+func TestObjectiveServerUnexpectedValue(t *testing.T) {
+	objective, _ := statusTestObjective()
+	var value model.Value = &model.Scalar{Value: 1}
+	server := &objectiveServer{
+		logger: log.NewNopLogger(),
+		promAPI: &promCache{api: &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+			return value
+		}}},
+		client: &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	_, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{}))
+	require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+	require.EqualError(t, err, "aborted: total: expected vector, got scalar: the recording rule must produce an instant vector")
+
+	_, err = server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{}))
+	require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	require.EqualError(t, err, "internal: expected matrix, got scalar")
+
+	value = nil
+	_, err = server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{}))
+	require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	require.EqualError(t, err, "internal: expected matrix, got nothing")
+
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{}))
+	require.EqualError(t, err, "internal: total: expected vector, got nothing")
+}
+
+func BenchmarkObjectiveServerGetStatus(b *testing.B) {
+	objective, api := statusTestObjective()
+	api.delay = time.Millisecond
+
+	b.Run("serial", func(b *testing.B) {
+		pc := &promCache{api: api}
+		queryTotal := objective.QueryTotal(objective.Window)
+		queryErrors := objective.QueryErrors(objective.Window)
+		for i := 0; i < b.N; i++ {
+			_, _, _ = pc.Query(context.Background(), queryTotal, time.Now())
+			_, _, _ = pc.Query(context.Background(), queryErrors, time.Now())
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		server := &objectiveServer{
+			logger:  log.NewNopLogger(),
+			promAPI: &promCache{api: api},
+			client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+		}
+		req := connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Expr: `{__name__="http-errors"}`})
+		for i := 0; i < b.N; i++ {
+			_, _ = server.GetStatus(context.Background(), req)
+		}
+	})
+}
+
+func TestObjectiveServerErrors(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Matrix{}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	_, err := server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.ErrorIs(t, err, errNoData)
+
+	server.client = &fakeBackendClient{}
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	require.EqualError(t, err, "not_found: expr matches no SLO")
+
+	other := objective
+	other.Labels = labels.FromStrings(labels.MetricName, "http-errors", "namespace", "other")
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective, other}}
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{}))
+	require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+	require.EqualError(t, err, `aborted: expr matches more than one SLO: {__name__="http-errors"}, {__name__="http-errors", namespace="other"}`)
+
+	var connectErr *connect.Error
+	require.ErrorAs(t, err, &connectErr)
+	require.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	require.Len(t, detail.(*objectivesv1alpha1.ListResponse).Objectives, 2)
+}
+
+func TestObjectiveServerObjectivesMatched(t *testing.T) {
+	objective, api := statusTestObjective()
+	other := objective
+	other.Labels = labels.FromStrings(labels.MetricName, "http-errors", "namespace", "other")
+
+	matched := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pyrra_api_objectives_matched",
+		Help:    "The number of objectives matched.",
+		Buckets: []float64{0, 1, 2},
+	}, []string{"procedure"})
+	server := &objectiveServer{
+		logger:            log.NewNopLogger(),
+		promAPI:           &promCache{api: api},
+		objectivesMatched: matched,
+	}
+
+	for _, objectives := range [][]slo.Objective{nil, {objective}, {objective, other}} {
+		server.client = &fakeBackendClient{objectives: objectives}
+		_, _ = server.getObjective(context.Background(), "/objectives.v1alpha1.ObjectiveService/GetStatus", "")
+	}
+
+	require.NoError(t, testutil.CollectAndCompare(matched, strings.NewReader(`
+# HELP pyrra_api_objectives_matched The number of objectives matched.
+# TYPE pyrra_api_objectives_matched histogram
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetStatus",le="0"} 1
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetStatus",le="1"} 2
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetStatus",le="2"} 3
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetStatus",le="+Inf"} 3
+pyrra_api_objectives_matched_sum{procedure="/objectives.v1alpha1.ObjectiveService/GetStatus"} 3
+pyrra_api_objectives_matched_count{procedure="/objectives.v1alpha1.ObjectiveService/GetStatus"} 3
+`)))
+}
+
+func TestObjectiveServerGetSourceObjectivesMatched(t *testing.T) {
+	objective, _ := statusTestObjective()
+	matched := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pyrra_api_objectives_matched",
+		Help:    "The number of objectives matched.",
+		Buckets: []float64{0, 1, 2},
+	}, []string{"procedure"})
+	server := &objectiveServer{
+		logger:            log.NewNopLogger(),
+		client:            &fakeBackendClient{objectives: []slo.Objective{objective}},
+		objectivesMatched: matched,
+	}
+	_, handler := objectivesv1alpha1connect.NewObjectiveServiceHandler(server)
+	httpServer := httptest.NewServer(handler)
+	defer httpServer.Close()
+	client := objectivesv1alpha1connect.NewObjectiveServiceClient(httpServer.Client(), httpServer.URL)
+
+	_, err := client.GetSource(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSourceRequest{}))
+	require.NoError(t, err)
+
+	require.NoError(t, testutil.CollectAndCompare(matched, strings.NewReader(`
+# HELP pyrra_api_objectives_matched The number of objectives matched.
+# TYPE pyrra_api_objectives_matched histogram
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetSource",le="0"} 0
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetSource",le="1"} 1
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetSource",le="2"} 1
+pyrra_api_objectives_matched_bucket{procedure="/objectives.v1alpha1.ObjectiveService/GetSource",le="+Inf"} 1
+pyrra_api_objectives_matched_sum{procedure="/objectives.v1alpha1.ObjectiveService/GetSource"} 1
+pyrra_api_objectives_matched_count{procedure="/objectives.v1alpha1.ObjectiveService/GetSource"} 1
+`)))
+}
+
+func TestObjectiveServerListSorted(t *testing.T) {
+	objective := func(name, namespace string, window time.Duration) slo.Objective {
+		return slo.Objective{
+			Labels: labels.FromStrings(labels.MetricName, name, "namespace", namespace),
+			Target: 0.99,
+			Window: model.Duration(window),
+			Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+				Errors: slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+				Total:  slo.Metric{Name: "http_requests_total"},
+			}},
+		}
+	}
+	objectives := []slo.Objective{
+		objective("c", "monitoring", 7*24*time.Hour),
+		objective("a", "monitoring", 28*24*time.Hour),
+		objective("b", "monitoring", 14*24*time.Hour),
+		objective("a", "default", 28*24*time.Hour),
+	}
+
+	names := func(resp *connect.Response[objectivesv1alpha1.ListResponse]) []string {
+		var names []string
+		for _, o := range resp.Msg.Objectives {
+			names = append(names, o.Labels[labels.MetricName]+"/"+o.Labels["namespace"])
+		}
+		return names
+	}
+
+	for i := 0; i < 10; i++ {
+		shuffled := make([]slo.Objective, len(objectives))
+		copy(shuffled, objectives)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		server := &objectiveServer{client: &fakeBackendClient{objectives: shuffled}}
+
+		resp, err := server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+		require.NoError(t, err)
+		require.Equal(t, []string{"a/default", "a/monitoring", "b/monitoring", "c/monitoring"}, names(resp))
+
+		resp, err = server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{SortBy: "window"}))
+		require.NoError(t, err)
+		require.Equal(t, []string{"c/monitoring", "b/monitoring", "a/default", "a/monitoring"}, names(resp))
+	}
+
+	server := &objectiveServer{client: &fakeBackendClient{objectives: objectives}}
+	_, err := server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{SortBy: "target"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestObjectiveServerListFiltered(t *testing.T) {
+	objective := func(name string, target float64, window time.Duration) slo.Objective {
+		return slo.Objective{
+			Labels: labels.FromStrings(labels.MetricName, name),
+			Target: target,
+			Window: model.Duration(window),
+			Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+				Errors: slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+				Total:  slo.Metric{Name: "http_requests_total"},
+			}},
+		}
+	}
+	server := &objectiveServer{client: &fakeBackendClient{objectives: []slo.Objective{
+		objective("a", 0.99, 28*24*time.Hour),
+		objective("b", 0.999, 28*24*time.Hour),
+		objective("c", 0.95, 7*24*time.Hour),
+	}}}
+
+	list := func(req *objectivesv1alpha1.ListRequest) []string {
+		resp, err := server.List(context.Background(), connect.NewRequest(req))
+		require.NoError(t, err)
+		var names []string
+		for _, o := range resp.Msg.Objectives {
+			names = append(names, o.Labels[labels.MetricName])
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"a", "b", "c"}, list(&objectivesv1alpha1.ListRequest{}))
+	require.Equal(t, []string{"a", "b"}, list(&objectivesv1alpha1.ListRequest{Window: "4w"}))
+	require.Equal(t, []string{"c"}, list(&objectivesv1alpha1.ListRequest{Window: "7d"}))
+	require.Equal(t, []string{"a", "b"}, list(&objectivesv1alpha1.ListRequest{TargetMin: 0.99}))
+	require.Equal(t, []string{"a", "c"}, list(&objectivesv1alpha1.ListRequest{TargetMax: 0.99}))
+	require.Equal(t, []string{"a"}, list(&objectivesv1alpha1.ListRequest{Window: "28d", TargetMin: 0.99, TargetMax: 0.99}))
+	require.Empty(t, list(&objectivesv1alpha1.ListRequest{Window: "1d"}))
+
+	for _, req := range []*objectivesv1alpha1.ListRequest{
+		{Window: "28 days"},
+		{TargetMin: -1},
+		{TargetMin: 0.999, TargetMax: 0.99},
+	} {
+		_, err := server.List(context.Background(), connect.NewRequest(req))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), req.String())
+	}
+}
+
+func TestObjectiveServerListFilteredCached(t *testing.T) {
+	objective := func(name string, window time.Duration) slo.Objective {
+		return slo.Objective{
+			Labels: labels.FromStrings(labels.MetricName, name),
+			Target: 0.99,
+			Window: model.Duration(window),
+			Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+				Errors: slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+				Total:  slo.Metric{Name: "http_requests_total"},
+			}},
+		}
+	}
+	backend := &fakeBackendClient{objectives: []slo.Objective{
+		objective("b", 28*24*time.Hour),
+		objective("a", 7*24*time.Hour),
+	}}
+	client := newBackendClientCache(backend, time.Minute)
+	server := &objectiveServer{client: client}
+
+	resp, err := server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{Window: "7d"}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Objectives, 1)
+	client.(*backendClientCache).cache.Wait()
+
+	// Filtering and sorting the listed objectives must not modify the cached response.
+	cached, err := client.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, 1, backend.lists)
+	require.Len(t, cached.Msg.Objectives, 2)
+	require.Equal(t, "b", cached.Msg.Objectives[0].Labels[labels.MetricName])
+	require.Equal(t, "a", cached.Msg.Objectives[1].Labels[labels.MetricName])
+}
+
+func TestObjectiveServerListValidate(t *testing.T) {
+	objective := func(name string) slo.Objective {
+		return slo.Objective{
+			Labels: labels.FromStrings(labels.MetricName, name),
+			Target: 0.99,
+			Window: model.Duration(28 * 24 * time.Hour),
+			Indicator: slo.Indicator{Ratio: &slo.RatioIndicator{
+				Errors: slo.Metric{Name: "http_requests_total", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchRegexp, "code", "5..")}},
+				Total:  slo.Metric{Name: "http_requests_total"},
+			}},
+		}
+	}
+	api := &fakePrometheusAPI{value: func(query string, _ time.Time) model.Value {
+		switch {
+		case strings.Contains(query, `slo="configured"`):
+			return model.Vector{{Metric: model.Metric{}, Value: 1}}
+		case strings.Contains(query, `slo="missing"`):
+			return model.Vector{}
+		default:
+			return &model.Scalar{Value: 1}
+		}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client: &fakeBackendClient{objectives: []slo.Objective{
+			objective("configured"),
+			objective("missing"),
+			objective("unexpected"),
+		}},
+	}
+
+	resp, err := server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, api.queried)
+	for _, o := range resp.Msg.Objectives {
+		require.Equal(t, objectivesv1alpha1.Objective_unvalidated, o.RulesState)
+	}
+
+	resp, err = server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{Validate: true}))
+	require.NoError(t, err)
+	require.Contains(t, api.queried, `count(sum(http_requests:increase4w{slo="missing"}))`)
+
+	states := map[string]objectivesv1alpha1.Objective_RulesState{}
+	for _, o := range resp.Msg.Objectives {
+		states[o.Labels[labels.MetricName]] = o.RulesState
+	}
+	require.Equal(t, map[string]objectivesv1alpha1.Objective_RulesState{
+		"configured": objectivesv1alpha1.Objective_configured,
+		"missing":    objectivesv1alpha1.Objective_missingRules,
+		"unexpected": objectivesv1alpha1.Objective_unvalidated,
+	}, states)
+}
+
+func TestObjectiveServerGraphDurationPercentiles(t *testing.T) {
+	latency := slo.Objective{
+		Labels: labels.FromStrings(labels.MetricName, "api-latency"),
+		Target: 0.99,
+		Window: model.Duration(28 * 24 * time.Hour),
+		Indicator: slo.Indicator{Latency: &slo.LatencyIndicator{
+			Success: slo.Metric{Name: "http_request_duration_seconds_bucket", LabelMatchers: []*labels.Matcher{labels.MustNewMatcher(labels.MatchEqual, "le", "1")}},
+			Total:   slo.Metric{Name: "http_request_duration_seconds_count"},
+		}},
+	}
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Timestamp: model.TimeFromUnixNano(ts.UnixNano()), Value: 0.1}}}}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{latency}},
+	}
+
+	resp, err := server.GraphDuration(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphDurationRequest{
+		Percentiles: []float64{0.5, 0.9},
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Timeseries, 2)
+	require.Equal(t, []string{`{quantile="p90"}`}, resp.Msg.Timeseries[0].Labels)
+	require.Equal(t, []string{`{quantile="p50"}`}, resp.Msg.Timeseries[1].Labels)
+	require.Len(t, api.queried, 2)
+	require.True(t, strings.HasPrefix(api.queried[0], "histogram_quantile(0.9,"), api.queried[0])
+	require.True(t, strings.HasPrefix(api.queried[1], "histogram_quantile(0.5,"), api.queried[1])
+
+	_, err = server.GraphDuration(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphDurationRequest{
+		Percentiles: []float64{1.5},
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	ratio, _ := statusTestObjective()
+	server.client = &fakeBackendClient{objectives: []slo.Objective{ratio}}
+	_, err = server.GraphDuration(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphDurationRequest{}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+}
+
+func TestObjectiveServerQueryStep(t *testing.T) {
+	end := time.Now()
+	server := &objectiveServer{maxQueryResolution: 1000, minQueryStep: 15 * time.Second}
+	require.Equal(t, 15*time.Second, server.queryStep(end.Add(-time.Hour), end))
+	require.Equal(t, 2419200*time.Millisecond, server.queryStep(end.Add(-28*24*time.Hour), end))
+
+	server = &objectiveServer{maxQueryResolution: 100}
+	require.Equal(t, 36*time.Second, server.queryStep(end.Add(-time.Hour), end))
+}
+
+func TestObjectiveServerDefaultErrorBudgetRange(t *testing.T) {
+	objective := slo.Objective{Window: model.Duration(28 * 24 * time.Hour)}
+
+	server := &objectiveServer{}
+	require.Equal(t, 28*24*time.Hour, server.defaultErrorBudgetRange(objective))
+
+	server = &objectiveServer{errorBudgetRange: 7 * 24 * time.Hour}
+	require.Equal(t, 7*24*time.Hour, server.defaultErrorBudgetRange(objective))
+
+	server = &objectiveServer{errorBudgetRange: 90 * 24 * time.Hour}
+	require.Equal(t, 28*24*time.Hour, server.defaultErrorBudgetRange(objective))
+}
+
+func TestObjectiveServerGraphErrorBudgetDefaultRange(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Timestamp: model.TimeFromUnix(ts.Unix()), Value: 0.5}}}}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	// Without start and end the step covers the objective's window, not the time since 1970.
+	resp, err := server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{}))
+	require.NoError(t, err)
+	end := time.Now()
+	require.Equal(t, server.queryStep(end.Add(-28*24*time.Hour), end), resp.Msg.Timeseries.Step.AsDuration())
+
+	server.errorBudgetRange = 7 * 24 * time.Hour
+	resp, err = server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, server.queryStep(end.Add(-7*24*time.Hour), end), resp.Msg.Timeseries.Step.AsDuration())
+
+	start := end.Add(-time.Hour)
+	resp, err = server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{
+		Start: timestamppb.New(start),
+		End:   timestamppb.New(end),
+	}))
+	require.NoError(t, err)
+	require.Equal(t, server.queryStep(start, end), resp.Msg.Timeseries.Step.AsDuration())
+}
+
+func TestObjectiveServerMaxQueryRange(t *testing.T) {
+	objective, api := statusTestObjective()
+	api.value = func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Timestamp: model.TimeFromUnixNano(ts.UnixNano()), Value: 1}}}}
+	}
+	server := &objectiveServer{
+		logger:        log.NewNopLogger(),
+		promAPI:       &promCache{api: api},
+		client:        &fakeBackendClient{objectives: []slo.Objective{objective}},
+		maxQueryRange: 365 * 24 * time.Hour,
+	}
+
+	end := time.Now()
+	start := timestamppb.New(end.Add(-2 * 365 * 24 * time.Hour))
+	_, err := server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{Start: start, End: timestamppb.New(end)}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.ErrorContains(t, err, "time range 2y is longer than the maximum of 1y")
+	_, err = server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{Start: start, End: timestamppb.New(end)}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	_, err = server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{Start: start, End: timestamppb.New(end)}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Empty(t, api.queried)
+
+	start = timestamppb.New(end.Add(-365 * 24 * time.Hour))
+	_, err = server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{Start: start, End: timestamppb.New(end)}))
+	require.NoError(t, err)
+	_, err = server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{Start: start, End: timestamppb.New(end)}))
+	require.NoError(t, err)
+
+	// Without a limit any range is queried.
+	server.maxQueryRange = 0
+	start = timestamppb.New(end.Add(-5 * 365 * 24 * time.Hour))
+	_, err = server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{Start: start, End: timestamppb.New(end)}))
+	require.NoError(t, err)
+}
+
+func TestObjectiveServerExposeQueries(t *testing.T) {
+	objective, api := statusTestObjective()
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	api.errs = []error{context.DeadlineExceeded}
+	_, err := server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{}))
+	require.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	require.NotContains(t, err.Error(), api.queried[len(api.queried)-1])
+
+	server.exposeQueries = true
+	api.errs = []error{context.DeadlineExceeded}
+	_, err = server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{}))
+	require.Equal(t, connect.CodeDeadlineExceeded, connect.CodeOf(err))
+	require.ErrorContains(t, err, "query "+api.queried[len(api.queried)-1]+" failed")
+
+	api.errs = []error{errors.New("bad_data: parse error")}
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{}))
+	require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	require.ErrorContains(t, err, "http_requests:increase4w")
+	require.ErrorContains(t, err, "bad_data: parse error")
+
+	// The alerts are queried first, then the first current burn rate fails.
+	api.errs = []error{nil, errors.New("bad_data: parse error")}
+	resp, err := server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{
+		Inactive: true,
+		Current:  true,
+	}))
+	require.NoError(t, err)
+	var burnrateErrors []string
+	for _, a := range resp.Msg.Alerts {
+		for _, b := range []*objectivesv1alpha1.Burnrate{a.Short, a.Long} {
+			if b.Error != "" {
+				burnrateErrors = append(burnrateErrors, b.Error)
+			}
+		}
+	}
+	require.NotEmpty(t, burnrateErrors)
+	for _, e := range burnrateErrors {
+		require.Regexp(t, `^query .+ failed: .*bad_data: parse error$`, e)
+	}
+}
+
+func TestObjectiveServerQueryOffset(t *testing.T) {
+	objective, api := statusTestObjective()
+	var evaluated []time.Time
+	api.value = func(_ string, ts time.Time) model.Value {
+		evaluated = append(evaluated, ts)
+		return model.Matrix{{Values: []model.SamplePair{{Timestamp: model.TimeFromUnixNano(ts.UnixNano()), Value: 1}}}}
+	}
+	server := &objectiveServer{
+		logger:      log.NewNopLogger(),
+		promAPI:     &promCache{api: api},
+		client:      &fakeBackendClient{objectives: []slo.Objective{objective}},
+		queryOffset: 10 * time.Minute,
+	}
+
+	before := time.Now()
+	_, err := server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.NoError(t, err)
+	require.Len(t, evaluated, 1)
+	require.WithinDuration(t, before.Add(-10*time.Minute), evaluated[0], time.Second)
+
+	// Explicit ranges aren't shifted.
+	end := time.Now().Add(-time.Hour)
+	_, err = server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{
+		Expr:  `{__name__="http-errors"}`,
+		Start: timestamppb.New(end.Add(-time.Hour)),
+		End:   timestamppb.New(end),
+	}))
+	require.NoError(t, err)
+	require.Len(t, evaluated, 2)
+	require.True(t, end.Equal(evaluated[1]))
+}
+
+func TestInstrumentHandler(t *testing.T) {
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "pyrra_api_http_request_duration_seconds",
+	}, []string{"handler", "code"})
+
+	handler := instrumentHandler(duration, "readyz", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	require.Equal(t, 1, testutil.CollectAndCount(duration))
+	require.True(t, duration.DeleteLabelValues("readyz", "503"))
+}
+
+func TestAccessLog(t *testing.T) {
+	var buf strings.Builder
+	handler := accessLog(log.NewLogfmtLogger(&buf), "/pyrra/", []string{"/metrics"})(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("not found"))
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/pyrra/metrics", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Empty(t, buf.String())
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/pyrra/objectives", nil))
+	require.Contains(t, buf.String(), "method=POST path=/pyrra/objectives status=404 size=9 duration=")
+}
+
+func TestValueCost(t *testing.T) {
+	metric := model.Metric{"job": "api"} // 32 + 3 + 3 bytes
+	require.Equal(t, int64(64), valueCost(model.Vector{}))
+	require.Equal(t, int64(64+38+16), valueCost(model.Vector{{Metric: metric, Value: 1}}))
+	require.Equal(t, int64(16), valueCost(&model.Scalar{Value: 1}))
+
+	hour := model.Matrix{{Metric: metric, Values: make([]model.SamplePair, 60)}}
+	year := model.Matrix{{Metric: metric, Values: make([]model.SamplePair, 365*24*60)}}
+	require.Equal(t, int64(64+38+60*16), valueCost(hour))
+	require.Equal(t, int64(64+38+365*24*60*16), valueCost(year))
+
+	histograms := model.Matrix{{Metric: metric, Histograms: []model.SampleHistogramPair{{
+		Histogram: &model.SampleHistogram{Buckets: make(model.HistogramBuckets, 10)},
+	}}}}
+	require.Equal(t, int64(64+38+16+48+10*32), valueCost(histograms))
+}
+
+func TestPromCacheNegativeTTL(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{}
+	}}
+	pc := newTestPromCache(t, api)
+	pc.negativeTTL = 100 * time.Millisecond
+	ctx := contextSetPromCache(context.Background(), time.Hour)
+	ts := time.Unix(1_700_000_000, 0)
+
+	_, _, err := pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+	pc.cache.Wait()
+
+	_, _, err = pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+	require.Equal(t, 1, api.queries)
+
+	// Empty results expire after the negative TTL even though the query requested a longer TTL.
+	time.Sleep(200 * time.Millisecond)
+	_, _, err = pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+	require.Equal(t, 2, api.queries)
+
+	pc.negativeTTL = 0
+	_, _, err = pc.Query(ctx, "down", ts)
+	require.NoError(t, err)
+	pc.cache.Wait()
+	_, _, err = pc.Query(ctx, "down", ts)
+	require.NoError(t, err)
+	require.Equal(t, 4, api.queries)
+}
+
+func TestPromCacheNegativeTTLShortQueryTTL(t *testing.T) {
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{}
+	}}
+	pc := newTestPromCache(t, api)
+	pc.negativeTTL = time.Hour
+	ts := time.Unix(1_700_000_000, 0)
+
+	// Empty results aren't cached for longer than the query requested, e.g. for the ALERTS of an alert starting to fire.
+	_, _, err := pc.Query(contextSetPromCache(context.Background(), 100*time.Millisecond), "ALERTS", ts)
+	require.NoError(t, err)
+	pc.cache.Wait()
+	_, _, err = pc.QueryRange(contextSetPromCache(context.Background(), 100*time.Millisecond), "ALERTS", prometheusapiv1.Range{Start: ts.Add(-time.Hour), End: ts, Step: time.Minute})
+	require.NoError(t, err)
+	pc.cache.Wait()
+	require.Equal(t, 2, api.queries)
+
+	time.Sleep(200 * time.Millisecond)
+	_, _, err = pc.Query(contextSetPromCache(context.Background(), 100*time.Millisecond), "ALERTS", ts)
+	require.NoError(t, err)
+	_, _, err = pc.QueryRange(contextSetPromCache(context.Background(), 100*time.Millisecond), "ALERTS", prometheusapiv1.Range{Start: ts.Add(-time.Hour), End: ts, Step: time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, 4, api.queries)
+}
+
+func TestObjectiveServerWarnings(t *testing.T) {
+	objective, api := statusTestObjective()
+	api.warnings = prometheusapiv1.Warnings{"partial response"}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Status, 1)
+	require.Equal(t, []string{"partial response"}, resp.Msg.Status[0].Warnings)
+
+	alerts, err := server.GetAlerts(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertsRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"partial response"}, alerts.Msg.Warnings)
+}
+
+func TestObjectiveServerGetAlertRules(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetAlertRules(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetAlertRulesRequest{}))
+	require.NoError(t, err)
+	require.Equal(t, 0, api.queries)
+	require.Len(t, resp.Msg.Rules, len(objective.Windows()))
+
+	rule := resp.Msg.Rules[0]
+	require.Equal(t, "critical", rule.Severity)
+	require.Equal(t, 14.0, rule.Factor)
+	require.InDelta(t, 0.14, rule.Threshold, 1e-9)
+	require.Equal(t, time.Hour, rule.Long.Window.AsDuration())
+	require.Equal(t, `http_requests:burnrate1h{slo="http-errors"}`, rule.Long.Query)
+}
+
+func TestBackendClientCache(t *testing.T) {
+	objective, api := statusTestObjective()
+	backend := &fakeBackendClient{objectives: []slo.Objective{objective}}
+	client := newBackendClientCache(backend, time.Minute)
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  client,
+	}
+
+	req := connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Expr: `{__name__="http-errors"}`})
+	_, err := server.GetStatus(context.Background(), req)
+	require.NoError(t, err)
+	client.(*backendClientCache).cache.Wait()
+
+	_, err = server.GetStatus(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, 1, backend.lists)
+
+	// Listing the objectives bypasses the cache.
+	_, err = server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{Expr: `{__name__="http-errors"}`}))
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.lists)
+
+	require.Equal(t, backend, newBackendClientCache(backend, 0))
+}
+
+func TestMultiBackendClient(t *testing.T) {
+	objective, api := statusTestObjective()
+	other := objective
+	other.Labels = labels.FromStrings(labels.MetricName, "http-errors", "namespace", "other")
+
+	filesystem := &fakeBackendClient{objectives: []slo.Objective{objective}}
+	kubernetes := &fakeBackendClient{objectives: []slo.Objective{objective, other}}
+	down := &fakeBackendClient{err: connect.NewError(connect.CodeUnavailable, errors.New("connection refused"))}
+	urls := []*url.URL{{Host: "filesystem:9444"}, {Host: "kubernetes:9444"}, {Host: "down:9444"}}
+
+	client := newMultiBackendClient(log.NewNopLogger(), urls, []objectivesv1alpha1connect.ObjectiveBackendServiceClient{filesystem, kubernetes, down})
+	resp, err := client.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Objectives, 2)
+	require.Equal(t, objective.Labels, objectivesv1alpha1.ToInternal(resp.Msg.Objectives[0]).Labels)
+	require.Equal(t, other.Labels, objectivesv1alpha1.ToInternal(resp.Msg.Objectives[1]).Labels)
+	require.True(t, partialList(resp.Header()))
+
+	// An objective missing from a partial list might be served by the failed backend.
+	partial := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  newMultiBackendClient(log.NewNopLogger(), urls[1:], []objectivesv1alpha1connect.ObjectiveBackendServiceClient{&fakeBackendClient{}, down}),
+	}
+	_, err = partial.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr: `{__name__="unknown"}`,
+	}))
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	_, err = partial.GetSource(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSourceRequest{
+		Expr: `{__name__="unknown"}`,
+	}))
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	listResp, err := partial.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	require.NoError(t, err)
+	require.True(t, partialList(listResp.Header()))
+
+	// The same objective served by multiple backends isn't ambiguous.
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client: newMultiBackendClient(log.NewNopLogger(), urls[:2], []objectivesv1alpha1connect.ObjectiveBackendServiceClient{
+			filesystem,
+			&fakeBackendClient{objectives: []slo.Objective{objective}},
+		}),
+	}
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.NoError(t, err)
+	listResp, err = server.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	require.NoError(t, err)
+	require.False(t, partialList(listResp.Header()))
+
+	server.client = newMultiBackendClient(log.NewNopLogger(), urls[:2], []objectivesv1alpha1connect.ObjectiveBackendServiceClient{&fakeBackendClient{}, &fakeBackendClient{}})
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Expr: `{__name__="unknown"}`,
+	}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	client = newMultiBackendClient(log.NewNopLogger(), urls[1:], []objectivesv1alpha1connect.ObjectiveBackendServiceClient{
+		down,
+		&fakeBackendClient{err: errors.New("timeout")},
+	})
+	_, err = client.List(context.Background(), connect.NewRequest(&objectivesv1alpha1.ListRequest{}))
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+
+	require.Equal(t, filesystem, newMultiBackendClient(log.NewNopLogger(), urls[:1], []objectivesv1alpha1connect.ObjectiveBackendServiceClient{filesystem}))
+}
+
+func TestObjectiveServerGroupingValidation(t *testing.T) {
+	objective, api := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	_, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Grouping: `{job="api"}`,
+	}))
+	require.NoError(t, err)
+
+	_, err = server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Grouping: `{instance="localhost"}`,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.EqualError(t, err, `invalid_argument: invalid grouping label "instance": valid grouping labels are job`)
+
+	_, err = server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{
+		Grouping: `{instance="localhost"}`,
+	}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Equal(t, 2, api.queries)
+}
+
+func TestObjectiveServerGraphErrorBudgetWindow(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+		return model.Matrix{{Values: []model.SamplePair{{Timestamp: model.TimeFromUnix(ts.Unix()), Value: 0.5}}}}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{
+		Window: "1d",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, objective.QueryErrorBudgetWindow(model.Duration(24*time.Hour)), resp.Msg.Timeseries.Query)
+	require.Equal(t, []string{resp.Msg.Timeseries.Query}, api.queried)
+
+	for _, window := range []string{"foo", "0s", "8w"} {
+		_, err = server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{
+			Window: window,
+		}))
+		require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err), window)
+	}
+	require.Equal(t, 1, api.queries)
+}
+
+func TestObjectiveServerGraphAlerts(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+	end := time.Unix(1_700_000_000, 0)
+	var alerts model.Matrix
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return alerts
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+	req := &objectivesv1alpha1.GraphAlertsRequest{
+		Grouping: `{job="api"}`,
+		Start:    timestamppb.New(end.Add(-time.Hour)),
+		End:      timestamppb.New(end),
+	}
+
+	// No alerts within the range isn't an error.
+	resp, err := server.GraphAlerts(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	require.Empty(t, resp.Msg.Timeseries.Series)
+
+	query := resp.Msg.Timeseries.Query
+	require.Equal(t, `max by (short, long, severity) (ALERTS{slo="http-errors", alertstate="firing", job="api"} * 2 or ALERTS{slo="http-errors", alertstate="pending", job="api"})`, query)
+	_, err = parser.ParseExpr(query)
+	require.NoError(t, err)
+
+	alerts = model.Matrix{{
+		Metric: model.Metric{"long": "1h", "severity": "critical", "short": "5m"},
+		Values: []model.SamplePair{{Timestamp: model.TimeFromUnix(end.Unix() - 60), Value: 1}, {Timestamp: model.TimeFromUnix(end.Unix()), Value: 2}},
+	}, {
+		Metric: model.Metric{"long": "6h", "severity": "critical", "short": "30m"},
+		Values: []model.SamplePair{{Timestamp: model.TimeFromUnix(end.Unix()), Value: 1}},
+	}}
+	req.Start = timestamppb.New(end.Add(-2 * time.Hour))
+	resp, err = server.GraphAlerts(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`{long="1h", severity="critical", short="5m"}`,
+		`{long="6h", severity="critical", short="30m"}`,
+	}, resp.Msg.Timeseries.Labels)
+	require.Len(t, resp.Msg.Timeseries.Series, 3)
+	require.Equal(t, []float64{1, 2}, resp.Msg.Timeseries.Series[1].Values)
+	require.True(t, math.IsNaN(resp.Msg.Timeseries.Series[2].Values[0]))
+	require.Equal(t, float64(1), resp.Msg.Timeseries.Series[2].Values[1])
+
+	req.Grouping = `{instance="foo"}`
+	_, err = server.GraphAlerts(context.Background(), connect.NewRequest(req))
+	require.Error(t, err)
+}
+
+func TestAlertsRangeQuery(t *testing.T) {
+	objective := slo.Objective{Labels: labels.FromStrings(
+		labels.MetricName, "http-errors",
+		"namespace", "monitoring",
+		slo.PropagationLabelsPrefix+"team", "foo",
+	)}
+
+	query := alertsRangeQuery(objective, nil)
+	require.Equal(t, `max by (short, long, severity) (ALERTS{slo="http-errors", namespace=~"monitoring|", team="foo", alertstate="firing"} * 2 or ALERTS{slo="http-errors", namespace=~"monitoring|", team="foo", alertstate="pending"})`, query)
+	_, err := parser.ParseExpr(query)
+	require.NoError(t, err)
+}
+
+func TestObjectiveServerRegexGrouping(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+	var value model.Value
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return value
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+	grouping := `{job=~"api-.*"}`
+
+	value = model.Vector{
+		{Metric: model.Metric{"job": "api-1"}, Value: 10},
+		{Metric: model.Metric{"job": "api-2"}, Value: 20},
+	}
+	status, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{Grouping: grouping}))
+	require.NoError(t, err)
+	require.Len(t, status.Msg.Status, 2)
+
+	value = model.Matrix{{Metric: model.Metric{"job": "api-1"}, Values: []model.SamplePair{{Timestamp: 1000, Value: 1}}}}
+	_, err = server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{Grouping: grouping}))
+	require.NoError(t, err)
+	_, err = server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{Grouping: grouping}))
+	require.NoError(t, err)
+
+	// The total and errors queries of the status and the queries of both graphs select the same groups.
+	require.Len(t, api.queried, 4)
+	for _, query := range api.queried {
+		expr, err := parser.ParseExpr(query)
+		require.NoError(t, err)
+
+		var selectors int
+		parser.Inspect(expr, func(node parser.Node, _ []parser.Node) error {
+			if vs, ok := node.(*parser.VectorSelector); ok {
+				selectors++
+				matchers := make([]string, 0, len(vs.LabelMatchers))
+				for _, m := range vs.LabelMatchers {
+					matchers = append(matchers, m.String())
+				}
+				require.Contains(t, matchers, `job=~"api-.*"`, query)
+			}
+			return nil
+		})
+		require.NotZero(t, selectors, query)
+	}
+
+	merged := mergeGrouping(objective, []*labels.Matcher{labels.MustNewMatcher(labels.MatchNotEqual, "job", "api-1")})
+	require.Equal(t, `http_requests_total{code=~"5..",job!="api-1"}`, merged.Indicator.Ratio.Errors.Metric())
+	require.Equal(t, `http_requests_total{job!="api-1"}`, merged.Indicator.Ratio.Total.Metric())
+}
+
+func TestObjectiveServerStatusGrouping(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+
+	// Like Prometheus, only return a series per job if the query aggregates by job.
+	api := &fakePrometheusAPI{value: func(query string, _ time.Time) model.Value {
+		expr, err := parser.ParseExpr(query)
+		require.NoError(t, err)
+		agg, ok := expr.(*parser.AggregateExpr)
+		require.True(t, ok, query)
+		if !slices.Equal(agg.Grouping, []string{"job"}) {
+			return model.Vector{{Metric: model.Metric{}, Value: 300}}
+		}
+		if strings.Contains(query, `code=~"5.."`) {
+			return model.Vector{
+				{Metric: model.Metric{"job": "api"}, Value: 1},
+				{Metric: model.Metric{"job": "web"}, Value: 4},
+			}
+		}
+		return model.Vector{
+			{Metric: model.Metric{"job": "api"}, Value: 100},
+			{Metric: model.Metric{"job": "web"}, Value: 200},
+		}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetStatus(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetStatusRequest{
+		Grouping: `{job=~"api|web"}`,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Status, 2)
+
+	availability := map[string]*objectivesv1alpha1.Availability{}
+	for _, s := range resp.Msg.Status {
+		availability[s.Labels["job"]] = s.Availability
+	}
+	require.Equal(t, 100.0, availability["api"].Total)
+	require.Equal(t, 1.0, availability["api"].Errors)
+	require.Equal(t, 200.0, availability["web"].Total)
+	require.Equal(t, 4.0, availability["web"].Errors)
+}
+
+func TestObjectiveServerGraphRateAggregate(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Indicator.Ratio.Grouping = []string{"job"}
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Matrix{{Metric: model.Metric{"job": "api"}, Values: []model.SamplePair{{Timestamp: 1000, Value: 1}}}}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	for _, aggregate := range []string{"sum", "avg"} {
+		resp, err := server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{Aggregate: aggregate}))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(resp.Msg.Timeseries.Query, aggregate+" by (job) ("), resp.Msg.Timeseries.Query)
+		require.Equal(t, resp.Msg.Timeseries.Query, api.queried[len(api.queried)-1])
+	}
+
+	// Without grouping the requests are aggregated into a single series.
+	objective.Indicator.Ratio.Grouping = nil
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective}}
+	resp, err := server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{Aggregate: "sum"}))
+	require.NoError(t, err)
+	require.Equal(t, `sum(sum by (code) (rate(http_requests_total[5m])) > 0)`, resp.Msg.Timeseries.Query)
+
+	queried := len(api.queried)
+	_, err = server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{Aggregate: "max"}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+	require.Len(t, api.queried, queried)
+}
+
+func TestObjectiveServerDemo(t *testing.T) {
+	objective, _ := statusTestObjective()
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Matrix{}
+	}}
+	server := &objectiveServer{
+		logger:  log.NewNopLogger(),
+		promAPI: &promCache{api: api},
+		client:  &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	_, err := server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	server.demo = true
+	budget, err := server.GraphErrorBudget(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorBudgetRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, budget.Msg.Timeseries.Series)
+
+	rate, err := server.GraphRate(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphRateRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, rate.Msg.Timeseries.Series)
+
+	errs, err := server.GraphErrors(context.Background(), connect.NewRequest(&objectivesv1alpha1.GraphErrorsRequest{}))
+	require.NoError(t, err)
+	require.Empty(t, errs.Msg.Timeseries.Series)
+}
+
+func TestObjectiveServerGetSource(t *testing.T) {
+	objective, _ := statusTestObjective()
+	objective.Config = "# http-errors\napiVersion: pyrra.dev/v1alpha1\n"
+	server := &objectiveServer{
+		logger: log.NewNopLogger(),
+		client: &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetSource(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSourceRequest{
+		Expr: `{__name__="http-errors"}`,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, objective.Config, resp.Msg.Source)
+
+	server.client = &fakeBackendClient{}
+	_, err = server.GetSource(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSourceRequest{}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	server.client = &fakeBackendClient{objectives: []slo.Objective{objective, objective}}
+	_, err = server.GetSource(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetSourceRequest{}))
+	require.Equal(t, connect.CodeAborted, connect.CodeOf(err))
+}
+
+func TestObjectiveServerGetRules(t *testing.T) {
+	_, objective, err := objectiveFromFile("examples/prometheus-http.yaml")
+	require.NoError(t, err)
+	server := &objectiveServer{
+		logger: log.NewNopLogger(),
+		client: &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+
+	resp, err := server.GetRules(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetRulesRequest{
+		Expr: `{__name__="prometheus-api-query"}`,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Groups, 2)
+
+	increases := resp.Msg.Groups[0]
+	require.Equal(t, "prometheus-api-query-increase", increases.Name)
+	require.Equal(t, "1m", increases.Interval)
+	require.Equal(t, "prometheus_http_requests:increase1w", increases.Rules[0].Record)
+	require.Equal(t, `sum by (code, handler) (increase(prometheus_http_requests_total{handler=~"/api.*"}[1w]))`, increases.Rules[0].Expr)
+
+	burnrates := resp.Msg.Groups[1]
+	require.Equal(t, "prometheus-api-query", burnrates.Name)
+	var alerts []*objectivesv1alpha1.Rule
+	for _, r := range burnrates.Rules {
+		if r.Alert != "" {
+			alerts = append(alerts, r)
+		}
+	}
+	require.Len(t, alerts, 4)
+	require.Equal(t, "ErrorBudgetBurn", alerts[0].Alert)
+	require.Equal(t, "critical", alerts[0].Labels["severity"])
+	require.Equal(t, "1m0s", alerts[0].For)
+
+	server.client = &fakeBackendClient{}
+	_, err = server.GetRules(context.Background(), connect.NewRequest(&objectivesv1alpha1.GetRulesRequest{}))
+	require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
+
+func TestRulesFileHandler(t *testing.T) {
+	_, objective, err := objectiveFromFile("examples/prometheus-http.yaml")
+	require.NoError(t, err)
+	server := &objectiveServer{
+		logger: log.NewNopLogger(),
+		client: &fakeBackendClient{objectives: []slo.Objective{objective}},
+	}
+	r := chi.NewRouter()
+	r.Get("/api/v1/objectives/{name}/rules.yaml", rulesFileHandler(log.NewNopLogger(), server))
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/objectives/prometheus-api-query/rules.yaml", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/yaml", rec.Header().Get("Content-Type"))
+	require.Equal(t, `attachment; filename="prometheus-api-query.yaml"`, rec.Header().Get("Content-Disposition"))
+	require.True(t, strings.HasPrefix(rec.Body.String(), generatedRuleFileHeader))
+
+	var spec monitoringv1.PrometheusRuleSpec
+	require.NoError(t, yaml.Unmarshal(rec.Body.Bytes(), &spec))
+	require.Len(t, spec.Groups, 2)
+	require.Equal(t, "prometheus-api-query-increase", spec.Groups[0].Name)
+	require.Equal(t, "prometheus-api-query", spec.Groups[1].Name)
+
+	server.client = &fakeBackendClient{}
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/objectives/prometheus-api-query/rules.yaml", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, "expr matches no SLO\n", rec.Body.String())
+}
+
+func TestValidateHandler(t *testing.T) {
+	config, err := os.ReadFile("examples/prometheus-http.yaml")
+	require.NoError(t, err)
+	handler := validateHandler(log.NewNopLogger())
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/objectives/validate", bytes.NewReader(config)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var resp validateResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, "prometheus-api-query", resp.Name)
+	require.Contains(t, resp.Rules, "prometheus_http_requests:increase1w")
+	require.Contains(t, resp.Rules, "ErrorBudgetBurn")
+	require.Empty(t, resp.Errors)
+
+	// JSON configs are valid YAML.
+	jsonConfig, err := yaml.YAMLToJSON(config)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/objectives/validate", bytes.NewReader(jsonConfig)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	for _, tc := range []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{name: "unknown field", config: string(config) + "unknown: true\n", err: "failed to unmarshal objective"},
+		{name: "invalid", config: strings.Replace(string(config), "target: '99.0'", "target: '120'", 1), err: "invalid objective"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/objectives/validate", strings.NewReader(tc.config)))
+			require.Equal(t, http.StatusBadRequest, rec.Code)
+			var resp validateResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			require.Empty(t, resp.Rules)
+			require.Len(t, resp.Errors, 1)
+			require.Contains(t, resp.Errors[0], tc.err)
+		})
+	}
+}
+
+func TestRangeInterval(t *testing.T) {
+	end := time.Now()
+	for _, tc := range []struct {
+		timeRange time.Duration
+		base      time.Duration
+		expected  time.Duration
+	}{
+		{timeRange: time.Hour, expected: 5 * time.Minute},
+		{timeRange: 12 * time.Hour, expected: 15 * time.Minute},
+		{timeRange: day, expected: 30 * time.Minute},
+		{timeRange: week, expected: time.Hour},
+		{timeRange: month, expected: 3 * time.Hour},
+		{timeRange: time.Hour, base: 2 * time.Minute, expected: 2 * time.Minute},
+		{timeRange: week, base: 2 * time.Minute, expected: 24 * time.Minute},
+		{timeRange: month, base: 10 * time.Minute, expected: 6 * time.Hour},
+	} {
+		require.Equal(t, tc.expected, rangeInterval(end.Add(-tc.timeRange), end, tc.base))
+	}
+}
@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/go-kit/log"
 	prometheusapiv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
+	"golang.org/x/sync/errgroup"
 
 	v1 "github.com/pyrra-dev/pyrra/proto/prometheus/v1"
 )
@@ -15,12 +18,17 @@ import (
 type prometheusServer struct {
 	logger  log.Logger
 	promAPI *promCache
+	// secondary is the Prometheus to compare range queries against, nil if none is configured.
+	secondary *promCache
 }
 
+// comparisonLabel tells apart the series of the primary and secondary Prometheus in compared range queries.
+const comparisonLabel = "pyrra_prometheus"
+
 func (ps *prometheusServer) Query(ctx context.Context, req *connect.Request[v1.QueryRequest]) (*connect.Response[v1.QueryResponse], error) {
 	value, warnings, err := ps.promAPI.Query(ctx, req.Msg.Query, time.Unix(req.Msg.Time, 0))
 	if err != nil {
-		return nil, err
+		return nil, connect.NewError(queryErrorCode(err), err)
 	}
 
 	switch v := value.(type) {
@@ -61,13 +69,18 @@ func (ps *prometheusServer) Query(ctx context.Context, req *connect.Request[v1.Q
 }
 
 func (ps *prometheusServer) QueryRange(ctx context.Context, req *connect.Request[v1.QueryRangeRequest]) (*connect.Response[v1.QueryRangeResponse], error) {
-	value, warnings, err := ps.promAPI.QueryRange(ctx, req.Msg.GetQuery(), prometheusapiv1.Range{
+	r := prometheusapiv1.Range{
 		Start: time.Unix(req.Msg.GetStart(), 0),
 		End:   time.Unix(req.Msg.GetEnd(), 0),
 		Step:  time.Duration(req.Msg.GetStep()) * time.Second,
-	})
+	}
+	if req.Msg.GetCompare() {
+		return ps.compareRange(ctx, req.Msg.GetQuery(), r)
+	}
+
+	value, warnings, err := ps.promAPI.QueryRange(ctx, req.Msg.GetQuery(), r)
 	if err != nil {
-		return nil, err
+		return nil, connect.NewError(queryErrorCode(err), err)
 	}
 
 	switch v := value.(type) {
@@ -85,6 +98,63 @@ func (ps *prometheusServer) QueryRange(ctx context.Context, req *connect.Request
 	}), nil
 }
 
+// compareRange runs the range query against both the primary and the secondary Prometheus,
+// e.g. to compare an objective's error budget across regions or blue/green clusters.
+// The series of both are returned in a single matrix, labeled with the comparisonLabel.
+func (ps *prometheusServer) compareRange(ctx context.Context, query string, r prometheusapiv1.Range) (*connect.Response[v1.QueryRangeResponse], error) {
+	if ps.secondary == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no secondary Prometheus to compare against, see --prometheus-url-secondary"))
+	}
+
+	backends := []struct {
+		name string
+		api  *promCache
+	}{
+		{name: "primary", api: ps.promAPI},
+		{name: "secondary", api: ps.secondary},
+	}
+	matrices := make([]model.Matrix, len(backends))
+	warnings := make([]prometheusapiv1.Warnings, len(backends))
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i, b := range backends {
+		g.Go(func() error {
+			value, w, err := b.api.QueryRange(gctx, query, r)
+			if err != nil {
+				return fmt.Errorf("%s: %w", b.name, err)
+			}
+			matrix, ok := value.(model.Matrix)
+			if !ok {
+				return fmt.Errorf("%s: %w", b.name, errUnexpectedValue(model.ValMatrix, value))
+			}
+
+			// The matrix may be cached, label copies of the series instead of modifying them.
+			labeled := make(model.Matrix, 0, len(matrix))
+			for _, ss := range matrix {
+				metric := ss.Metric.Clone()
+				metric[comparisonLabel] = model.LabelValue(b.name)
+				labeled = append(labeled, &model.SampleStream{Metric: metric, Values: ss.Values, Histograms: ss.Histograms})
+			}
+			matrices[i] = labeled
+
+			for _, warning := range w {
+				warnings[i] = append(warnings[i], b.name+": "+warning)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, connect.NewError(queryErrorCode(err), err)
+	}
+
+	return connect.NewResponse(&v1.QueryRangeResponse{
+		Warnings: append(warnings[0], warnings[1]...),
+		Options: &v1.QueryRangeResponse_Matrix{
+			Matrix: convertMatrix(append(matrices[0], matrices[1]...)),
+		},
+	}), nil
+}
+
 func convertVector(in model.Vector) *v1.Vector {
 	samples := make([]*v1.Sample, 0, len(in))
 	for _, si := range in {
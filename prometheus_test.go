@@ -1,12 +1,65 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/bufbuild/connect-go"
+	"github.com/go-kit/log"
 	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/pyrra-dev/pyrra/proto/prometheus/v1"
 )
 
+func TestPrometheusServerQueryRangeCompare(t *testing.T) {
+	matrix := func(value model.SampleValue) *fakePrometheusAPI {
+		return &fakePrometheusAPI{value: func(_ string, ts time.Time) model.Value {
+			return model.Matrix{{
+				Metric: model.Metric{"job": "api"},
+				Values: []model.SamplePair{{Timestamp: model.TimeFromUnixNano(ts.UnixNano()), Value: value}},
+			}}
+		}}
+	}
+	primary, secondary := matrix(0.99), matrix(0.95)
+	ps := &prometheusServer{
+		logger:    log.NewNopLogger(),
+		promAPI:   &promCache{api: primary},
+		secondary: &promCache{api: secondary},
+	}
+	req := &v1.QueryRangeRequest{Query: "up", Start: 1669150000, End: 1669152000, Step: 60}
+
+	// Without compare only the primary Prometheus is queried.
+	resp, err := ps.QueryRange(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetMatrix().Samples, 1)
+	require.Equal(t, map[string]string{"job": "api"}, resp.Msg.GetMatrix().Samples[0].Metric)
+	require.Equal(t, 1, primary.queries)
+	require.Equal(t, 0, secondary.queries)
+
+	req.Compare = true
+	resp, err = ps.QueryRange(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+	samples := resp.Msg.GetMatrix().Samples
+	require.Len(t, samples, 2)
+	require.Equal(t, map[string]string{"job": "api", comparisonLabel: "primary"}, samples[0].Metric)
+	require.Equal(t, 0.99, samples[0].Values[0].Value)
+	require.Equal(t, map[string]string{"job": "api", comparisonLabel: "secondary"}, samples[1].Metric)
+	require.Equal(t, 0.95, samples[1].Values[0].Value)
+	require.Equal(t, 1, secondary.queries)
+
+	secondary.errs = []error{fmt.Errorf("unavailable")}
+	_, err = ps.QueryRange(context.Background(), connect.NewRequest(req))
+	require.Equal(t, connect.CodeInternal, connect.CodeOf(err))
+	require.Contains(t, err.Error(), "secondary: ")
+
+	ps.secondary = nil
+	_, err = ps.QueryRange(context.Background(), connect.NewRequest(req))
+	require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+}
+
 // go test -bench='BenchmarkPrometheus*' -count=5 | tee BenchmarkPrometheus
 
 func BenchmarkPrometheusConvertLabelSet(b *testing.B) {
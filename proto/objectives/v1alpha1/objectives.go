@@ -24,6 +24,7 @@ func ToInternal(o *Objective) slo.Objective {
 				Errors:   slo.Metric{Name: r.Errors.GetName()},
 				Total:    slo.Metric{Name: r.Total.GetName()},
 				Grouping: r.GetGrouping(),
+				Function: r.GetFunction(),
 			}
 			for _, m := range r.Errors.GetMatchers() {
 				ratio.Errors.LabelMatchers = append(ratio.Errors.LabelMatchers, &labels.Matcher{
@@ -46,6 +47,7 @@ func ToInternal(o *Objective) slo.Objective {
 				Success:  slo.Metric{Name: l.Success.GetName()},
 				Total:    slo.Metric{Name: l.Total.GetName()},
 				Grouping: l.GetGrouping(),
+				Function: l.GetFunction(),
 			}
 			for _, m := range l.Success.GetMatchers() {
 				latency.Success.LabelMatchers = append(latency.Success.LabelMatchers, &labels.Matcher{
@@ -96,13 +98,8 @@ func ToInternal(o *Objective) slo.Objective {
 		}
 	}
 
-	ls := make([]labels.Label, 0, len(o.Labels))
-	for name, value := range o.Labels {
-		ls = append(ls, labels.Label{Name: name, Value: value})
-	}
-
 	return slo.Objective{
-		Labels:      ls,
+		Labels:      labels.FromMap(o.Labels),
 		Description: o.Description,
 		Target:      o.Target,
 		Window:      model.Duration(o.Window.AsDuration()),
@@ -122,6 +119,7 @@ func FromInternal(o slo.Objective) *Objective {
 	if r := o.Indicator.Ratio; r != nil {
 		ratio = &Ratio{
 			Grouping: o.Grouping(),
+			Function: r.Function,
 			Errors: &Query{
 				Name:   r.Errors.Name,
 				Metric: r.Errors.Metric(),
@@ -151,6 +149,7 @@ func FromInternal(o slo.Objective) *Objective {
 	if l := o.Indicator.Latency; l != nil {
 		latency = &Latency{
 			Grouping: o.Grouping(),
+			Function: l.Function,
 			Success: &Query{
 				Name:   l.Success.Name,
 				Metric: l.Success.Metric(),
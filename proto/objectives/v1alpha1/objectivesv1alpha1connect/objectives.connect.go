@@ -31,11 +31,19 @@ const (
 type ObjectiveServiceClient interface {
 	List(context.Context, *connect_go.Request[v1alpha1.ListRequest]) (*connect_go.Response[v1alpha1.ListResponse], error)
 	GetStatus(context.Context, *connect_go.Request[v1alpha1.GetStatusRequest]) (*connect_go.Response[v1alpha1.GetStatusResponse], error)
+	ListObjectiveStatuses(context.Context, *connect_go.Request[v1alpha1.ListObjectiveStatusesRequest]) (*connect_go.Response[v1alpha1.ListObjectiveStatusesResponse], error)
 	GetAlerts(context.Context, *connect_go.Request[v1alpha1.GetAlertsRequest]) (*connect_go.Response[v1alpha1.GetAlertsResponse], error)
+	GetAlertRules(context.Context, *connect_go.Request[v1alpha1.GetAlertRulesRequest]) (*connect_go.Response[v1alpha1.GetAlertRulesResponse], error)
+	GetSource(context.Context, *connect_go.Request[v1alpha1.GetSourceRequest]) (*connect_go.Response[v1alpha1.GetSourceResponse], error)
+	GetRules(context.Context, *connect_go.Request[v1alpha1.GetRulesRequest]) (*connect_go.Response[v1alpha1.GetRulesResponse], error)
 	GraphErrorBudget(context.Context, *connect_go.Request[v1alpha1.GraphErrorBudgetRequest]) (*connect_go.Response[v1alpha1.GraphErrorBudgetResponse], error)
 	GraphRate(context.Context, *connect_go.Request[v1alpha1.GraphRateRequest]) (*connect_go.Response[v1alpha1.GraphRateResponse], error)
 	GraphErrors(context.Context, *connect_go.Request[v1alpha1.GraphErrorsRequest]) (*connect_go.Response[v1alpha1.GraphErrorsResponse], error)
 	GraphDuration(context.Context, *connect_go.Request[v1alpha1.GraphDurationRequest]) (*connect_go.Response[v1alpha1.GraphDurationResponse], error)
+	GraphAlerts(context.Context, *connect_go.Request[v1alpha1.GraphAlertsRequest]) (*connect_go.Response[v1alpha1.GraphAlertsResponse], error)
+	GetBudgetExhaustion(context.Context, *connect_go.Request[v1alpha1.GetBudgetExhaustionRequest]) (*connect_go.Response[v1alpha1.GetBudgetExhaustionResponse], error)
+	GetGroupingValues(context.Context, *connect_go.Request[v1alpha1.GetGroupingValuesRequest]) (*connect_go.Response[v1alpha1.GetGroupingValuesResponse], error)
+	GetSnapshot(context.Context, *connect_go.Request[v1alpha1.GetSnapshotRequest]) (*connect_go.Response[v1alpha1.GetSnapshotResponse], error)
 }
 
 // NewObjectiveServiceClient constructs a client for the objectives.v1alpha1.ObjectiveService
@@ -58,11 +66,31 @@ func NewObjectiveServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+"/objectives.v1alpha1.ObjectiveService/GetStatus",
 			opts...,
 		),
+		listObjectiveStatuses: connect_go.NewClient[v1alpha1.ListObjectiveStatusesRequest, v1alpha1.ListObjectiveStatusesResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/ListObjectiveStatuses",
+			opts...,
+		),
 		getAlerts: connect_go.NewClient[v1alpha1.GetAlertsRequest, v1alpha1.GetAlertsResponse](
 			httpClient,
 			baseURL+"/objectives.v1alpha1.ObjectiveService/GetAlerts",
 			opts...,
 		),
+		getAlertRules: connect_go.NewClient[v1alpha1.GetAlertRulesRequest, v1alpha1.GetAlertRulesResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GetAlertRules",
+			opts...,
+		),
+		getSource: connect_go.NewClient[v1alpha1.GetSourceRequest, v1alpha1.GetSourceResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GetSource",
+			opts...,
+		),
+		getRules: connect_go.NewClient[v1alpha1.GetRulesRequest, v1alpha1.GetRulesResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GetRules",
+			opts...,
+		),
 		graphErrorBudget: connect_go.NewClient[v1alpha1.GraphErrorBudgetRequest, v1alpha1.GraphErrorBudgetResponse](
 			httpClient,
 			baseURL+"/objectives.v1alpha1.ObjectiveService/GraphErrorBudget",
@@ -83,18 +111,46 @@ func NewObjectiveServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+"/objectives.v1alpha1.ObjectiveService/GraphDuration",
 			opts...,
 		),
+		graphAlerts: connect_go.NewClient[v1alpha1.GraphAlertsRequest, v1alpha1.GraphAlertsResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GraphAlerts",
+			opts...,
+		),
+		getBudgetExhaustion: connect_go.NewClient[v1alpha1.GetBudgetExhaustionRequest, v1alpha1.GetBudgetExhaustionResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GetBudgetExhaustion",
+			opts...,
+		),
+		getGroupingValues: connect_go.NewClient[v1alpha1.GetGroupingValuesRequest, v1alpha1.GetGroupingValuesResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GetGroupingValues",
+			opts...,
+		),
+		getSnapshot: connect_go.NewClient[v1alpha1.GetSnapshotRequest, v1alpha1.GetSnapshotResponse](
+			httpClient,
+			baseURL+"/objectives.v1alpha1.ObjectiveService/GetSnapshot",
+			opts...,
+		),
 	}
 }
 
 // objectiveServiceClient implements ObjectiveServiceClient.
 type objectiveServiceClient struct {
-	list             *connect_go.Client[v1alpha1.ListRequest, v1alpha1.ListResponse]
-	getStatus        *connect_go.Client[v1alpha1.GetStatusRequest, v1alpha1.GetStatusResponse]
-	getAlerts        *connect_go.Client[v1alpha1.GetAlertsRequest, v1alpha1.GetAlertsResponse]
-	graphErrorBudget *connect_go.Client[v1alpha1.GraphErrorBudgetRequest, v1alpha1.GraphErrorBudgetResponse]
-	graphRate        *connect_go.Client[v1alpha1.GraphRateRequest, v1alpha1.GraphRateResponse]
-	graphErrors      *connect_go.Client[v1alpha1.GraphErrorsRequest, v1alpha1.GraphErrorsResponse]
-	graphDuration    *connect_go.Client[v1alpha1.GraphDurationRequest, v1alpha1.GraphDurationResponse]
+	list                  *connect_go.Client[v1alpha1.ListRequest, v1alpha1.ListResponse]
+	getStatus             *connect_go.Client[v1alpha1.GetStatusRequest, v1alpha1.GetStatusResponse]
+	listObjectiveStatuses *connect_go.Client[v1alpha1.ListObjectiveStatusesRequest, v1alpha1.ListObjectiveStatusesResponse]
+	getAlerts             *connect_go.Client[v1alpha1.GetAlertsRequest, v1alpha1.GetAlertsResponse]
+	getAlertRules         *connect_go.Client[v1alpha1.GetAlertRulesRequest, v1alpha1.GetAlertRulesResponse]
+	getSource             *connect_go.Client[v1alpha1.GetSourceRequest, v1alpha1.GetSourceResponse]
+	getRules              *connect_go.Client[v1alpha1.GetRulesRequest, v1alpha1.GetRulesResponse]
+	graphErrorBudget      *connect_go.Client[v1alpha1.GraphErrorBudgetRequest, v1alpha1.GraphErrorBudgetResponse]
+	graphRate             *connect_go.Client[v1alpha1.GraphRateRequest, v1alpha1.GraphRateResponse]
+	graphErrors           *connect_go.Client[v1alpha1.GraphErrorsRequest, v1alpha1.GraphErrorsResponse]
+	graphDuration         *connect_go.Client[v1alpha1.GraphDurationRequest, v1alpha1.GraphDurationResponse]
+	graphAlerts           *connect_go.Client[v1alpha1.GraphAlertsRequest, v1alpha1.GraphAlertsResponse]
+	getBudgetExhaustion   *connect_go.Client[v1alpha1.GetBudgetExhaustionRequest, v1alpha1.GetBudgetExhaustionResponse]
+	getGroupingValues     *connect_go.Client[v1alpha1.GetGroupingValuesRequest, v1alpha1.GetGroupingValuesResponse]
+	getSnapshot           *connect_go.Client[v1alpha1.GetSnapshotRequest, v1alpha1.GetSnapshotResponse]
 }
 
 // List calls objectives.v1alpha1.ObjectiveService.List.
@@ -107,11 +163,31 @@ func (c *objectiveServiceClient) GetStatus(ctx context.Context, req *connect_go.
 	return c.getStatus.CallUnary(ctx, req)
 }
 
+// ListObjectiveStatuses calls objectives.v1alpha1.ObjectiveService.ListObjectiveStatuses.
+func (c *objectiveServiceClient) ListObjectiveStatuses(ctx context.Context, req *connect_go.Request[v1alpha1.ListObjectiveStatusesRequest]) (*connect_go.Response[v1alpha1.ListObjectiveStatusesResponse], error) {
+	return c.listObjectiveStatuses.CallUnary(ctx, req)
+}
+
 // GetAlerts calls objectives.v1alpha1.ObjectiveService.GetAlerts.
 func (c *objectiveServiceClient) GetAlerts(ctx context.Context, req *connect_go.Request[v1alpha1.GetAlertsRequest]) (*connect_go.Response[v1alpha1.GetAlertsResponse], error) {
 	return c.getAlerts.CallUnary(ctx, req)
 }
 
+// GetAlertRules calls objectives.v1alpha1.ObjectiveService.GetAlertRules.
+func (c *objectiveServiceClient) GetAlertRules(ctx context.Context, req *connect_go.Request[v1alpha1.GetAlertRulesRequest]) (*connect_go.Response[v1alpha1.GetAlertRulesResponse], error) {
+	return c.getAlertRules.CallUnary(ctx, req)
+}
+
+// GetSource calls objectives.v1alpha1.ObjectiveService.GetSource.
+func (c *objectiveServiceClient) GetSource(ctx context.Context, req *connect_go.Request[v1alpha1.GetSourceRequest]) (*connect_go.Response[v1alpha1.GetSourceResponse], error) {
+	return c.getSource.CallUnary(ctx, req)
+}
+
+// GetRules calls objectives.v1alpha1.ObjectiveService.GetRules.
+func (c *objectiveServiceClient) GetRules(ctx context.Context, req *connect_go.Request[v1alpha1.GetRulesRequest]) (*connect_go.Response[v1alpha1.GetRulesResponse], error) {
+	return c.getRules.CallUnary(ctx, req)
+}
+
 // GraphErrorBudget calls objectives.v1alpha1.ObjectiveService.GraphErrorBudget.
 func (c *objectiveServiceClient) GraphErrorBudget(ctx context.Context, req *connect_go.Request[v1alpha1.GraphErrorBudgetRequest]) (*connect_go.Response[v1alpha1.GraphErrorBudgetResponse], error) {
 	return c.graphErrorBudget.CallUnary(ctx, req)
@@ -132,15 +208,43 @@ func (c *objectiveServiceClient) GraphDuration(ctx context.Context, req *connect
 	return c.graphDuration.CallUnary(ctx, req)
 }
 
+// GraphAlerts calls objectives.v1alpha1.ObjectiveService.GraphAlerts.
+func (c *objectiveServiceClient) GraphAlerts(ctx context.Context, req *connect_go.Request[v1alpha1.GraphAlertsRequest]) (*connect_go.Response[v1alpha1.GraphAlertsResponse], error) {
+	return c.graphAlerts.CallUnary(ctx, req)
+}
+
+// GetBudgetExhaustion calls objectives.v1alpha1.ObjectiveService.GetBudgetExhaustion.
+func (c *objectiveServiceClient) GetBudgetExhaustion(ctx context.Context, req *connect_go.Request[v1alpha1.GetBudgetExhaustionRequest]) (*connect_go.Response[v1alpha1.GetBudgetExhaustionResponse], error) {
+	return c.getBudgetExhaustion.CallUnary(ctx, req)
+}
+
+// GetGroupingValues calls objectives.v1alpha1.ObjectiveService.GetGroupingValues.
+func (c *objectiveServiceClient) GetGroupingValues(ctx context.Context, req *connect_go.Request[v1alpha1.GetGroupingValuesRequest]) (*connect_go.Response[v1alpha1.GetGroupingValuesResponse], error) {
+	return c.getGroupingValues.CallUnary(ctx, req)
+}
+
+// GetSnapshot calls objectives.v1alpha1.ObjectiveService.GetSnapshot.
+func (c *objectiveServiceClient) GetSnapshot(ctx context.Context, req *connect_go.Request[v1alpha1.GetSnapshotRequest]) (*connect_go.Response[v1alpha1.GetSnapshotResponse], error) {
+	return c.getSnapshot.CallUnary(ctx, req)
+}
+
 // ObjectiveServiceHandler is an implementation of the objectives.v1alpha1.ObjectiveService service.
 type ObjectiveServiceHandler interface {
 	List(context.Context, *connect_go.Request[v1alpha1.ListRequest]) (*connect_go.Response[v1alpha1.ListResponse], error)
 	GetStatus(context.Context, *connect_go.Request[v1alpha1.GetStatusRequest]) (*connect_go.Response[v1alpha1.GetStatusResponse], error)
+	ListObjectiveStatuses(context.Context, *connect_go.Request[v1alpha1.ListObjectiveStatusesRequest]) (*connect_go.Response[v1alpha1.ListObjectiveStatusesResponse], error)
 	GetAlerts(context.Context, *connect_go.Request[v1alpha1.GetAlertsRequest]) (*connect_go.Response[v1alpha1.GetAlertsResponse], error)
+	GetAlertRules(context.Context, *connect_go.Request[v1alpha1.GetAlertRulesRequest]) (*connect_go.Response[v1alpha1.GetAlertRulesResponse], error)
+	GetSource(context.Context, *connect_go.Request[v1alpha1.GetSourceRequest]) (*connect_go.Response[v1alpha1.GetSourceResponse], error)
+	GetRules(context.Context, *connect_go.Request[v1alpha1.GetRulesRequest]) (*connect_go.Response[v1alpha1.GetRulesResponse], error)
 	GraphErrorBudget(context.Context, *connect_go.Request[v1alpha1.GraphErrorBudgetRequest]) (*connect_go.Response[v1alpha1.GraphErrorBudgetResponse], error)
 	GraphRate(context.Context, *connect_go.Request[v1alpha1.GraphRateRequest]) (*connect_go.Response[v1alpha1.GraphRateResponse], error)
 	GraphErrors(context.Context, *connect_go.Request[v1alpha1.GraphErrorsRequest]) (*connect_go.Response[v1alpha1.GraphErrorsResponse], error)
 	GraphDuration(context.Context, *connect_go.Request[v1alpha1.GraphDurationRequest]) (*connect_go.Response[v1alpha1.GraphDurationResponse], error)
+	GraphAlerts(context.Context, *connect_go.Request[v1alpha1.GraphAlertsRequest]) (*connect_go.Response[v1alpha1.GraphAlertsResponse], error)
+	GetBudgetExhaustion(context.Context, *connect_go.Request[v1alpha1.GetBudgetExhaustionRequest]) (*connect_go.Response[v1alpha1.GetBudgetExhaustionResponse], error)
+	GetGroupingValues(context.Context, *connect_go.Request[v1alpha1.GetGroupingValuesRequest]) (*connect_go.Response[v1alpha1.GetGroupingValuesResponse], error)
+	GetSnapshot(context.Context, *connect_go.Request[v1alpha1.GetSnapshotRequest]) (*connect_go.Response[v1alpha1.GetSnapshotResponse], error)
 }
 
 // NewObjectiveServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -160,11 +264,31 @@ func NewObjectiveServiceHandler(svc ObjectiveServiceHandler, opts ...connect_go.
 		svc.GetStatus,
 		opts...,
 	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/ListObjectiveStatuses", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/ListObjectiveStatuses",
+		svc.ListObjectiveStatuses,
+		opts...,
+	))
 	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetAlerts", connect_go.NewUnaryHandler(
 		"/objectives.v1alpha1.ObjectiveService/GetAlerts",
 		svc.GetAlerts,
 		opts...,
 	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetAlertRules", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GetAlertRules",
+		svc.GetAlertRules,
+		opts...,
+	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetSource", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GetSource",
+		svc.GetSource,
+		opts...,
+	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetRules", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GetRules",
+		svc.GetRules,
+		opts...,
+	))
 	mux.Handle("/objectives.v1alpha1.ObjectiveService/GraphErrorBudget", connect_go.NewUnaryHandler(
 		"/objectives.v1alpha1.ObjectiveService/GraphErrorBudget",
 		svc.GraphErrorBudget,
@@ -185,6 +309,26 @@ func NewObjectiveServiceHandler(svc ObjectiveServiceHandler, opts ...connect_go.
 		svc.GraphDuration,
 		opts...,
 	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GraphAlerts", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GraphAlerts",
+		svc.GraphAlerts,
+		opts...,
+	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetBudgetExhaustion", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GetBudgetExhaustion",
+		svc.GetBudgetExhaustion,
+		opts...,
+	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetGroupingValues", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GetGroupingValues",
+		svc.GetGroupingValues,
+		opts...,
+	))
+	mux.Handle("/objectives.v1alpha1.ObjectiveService/GetSnapshot", connect_go.NewUnaryHandler(
+		"/objectives.v1alpha1.ObjectiveService/GetSnapshot",
+		svc.GetSnapshot,
+		opts...,
+	))
 	return "/objectives.v1alpha1.ObjectiveService/", mux
 }
 
@@ -199,10 +343,26 @@ func (UnimplementedObjectiveServiceHandler) GetStatus(context.Context, *connect_
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetStatus is not implemented"))
 }
 
+func (UnimplementedObjectiveServiceHandler) ListObjectiveStatuses(context.Context, *connect_go.Request[v1alpha1.ListObjectiveStatusesRequest]) (*connect_go.Response[v1alpha1.ListObjectiveStatusesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.ListObjectiveStatuses is not implemented"))
+}
+
 func (UnimplementedObjectiveServiceHandler) GetAlerts(context.Context, *connect_go.Request[v1alpha1.GetAlertsRequest]) (*connect_go.Response[v1alpha1.GetAlertsResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetAlerts is not implemented"))
 }
 
+func (UnimplementedObjectiveServiceHandler) GetAlertRules(context.Context, *connect_go.Request[v1alpha1.GetAlertRulesRequest]) (*connect_go.Response[v1alpha1.GetAlertRulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetAlertRules is not implemented"))
+}
+
+func (UnimplementedObjectiveServiceHandler) GetSource(context.Context, *connect_go.Request[v1alpha1.GetSourceRequest]) (*connect_go.Response[v1alpha1.GetSourceResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetSource is not implemented"))
+}
+
+func (UnimplementedObjectiveServiceHandler) GetRules(context.Context, *connect_go.Request[v1alpha1.GetRulesRequest]) (*connect_go.Response[v1alpha1.GetRulesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetRules is not implemented"))
+}
+
 func (UnimplementedObjectiveServiceHandler) GraphErrorBudget(context.Context, *connect_go.Request[v1alpha1.GraphErrorBudgetRequest]) (*connect_go.Response[v1alpha1.GraphErrorBudgetResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GraphErrorBudget is not implemented"))
 }
@@ -219,6 +379,22 @@ func (UnimplementedObjectiveServiceHandler) GraphDuration(context.Context, *conn
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GraphDuration is not implemented"))
 }
 
+func (UnimplementedObjectiveServiceHandler) GraphAlerts(context.Context, *connect_go.Request[v1alpha1.GraphAlertsRequest]) (*connect_go.Response[v1alpha1.GraphAlertsResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GraphAlerts is not implemented"))
+}
+
+func (UnimplementedObjectiveServiceHandler) GetBudgetExhaustion(context.Context, *connect_go.Request[v1alpha1.GetBudgetExhaustionRequest]) (*connect_go.Response[v1alpha1.GetBudgetExhaustionResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetBudgetExhaustion is not implemented"))
+}
+
+func (UnimplementedObjectiveServiceHandler) GetGroupingValues(context.Context, *connect_go.Request[v1alpha1.GetGroupingValuesRequest]) (*connect_go.Response[v1alpha1.GetGroupingValuesResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetGroupingValues is not implemented"))
+}
+
+func (UnimplementedObjectiveServiceHandler) GetSnapshot(context.Context, *connect_go.Request[v1alpha1.GetSnapshotRequest]) (*connect_go.Response[v1alpha1.GetSnapshotResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("objectives.v1alpha1.ObjectiveService.GetSnapshot is not implemented"))
+}
+
 // ObjectiveBackendServiceClient is a client for the objectives.v1alpha1.ObjectiveBackendService
 // service.
 type ObjectiveBackendServiceClient interface {
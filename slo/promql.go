@@ -25,18 +25,18 @@ func (o Objective) QueryTotal(window model.Duration) string {
 	)
 	switch o.IndicatorType() {
 	case Ratio:
-		metric = increaseName(o.Indicator.Ratio.Total.Name, window)
+		metric = o.increaseName(o.Indicator.Ratio.Total.Name, window)
 		matchers = cloneMatchers(o.Indicator.Ratio.Total.LabelMatchers)
 		grouping = slices.Clone(o.Indicator.Ratio.Grouping)
 	case Latency:
-		metric = increaseName(o.Indicator.Latency.Total.Name, window)
+		metric = o.increaseName(o.Indicator.Latency.Total.Name, window)
 		grouping = slices.Clone(o.Indicator.Latency.Grouping)
 		matchers = append(
 			cloneMatchers(o.Indicator.Latency.Total.LabelMatchers),
 			&labels.Matcher{Type: labels.MatchEqual, Name: labels.BucketLabel, Value: ""},
 		)
 	case LatencyNative:
-		metric = increaseName(o.Indicator.LatencyNative.Total.Name, window)
+		metric = o.increaseName(o.Indicator.LatencyNative.Total.Name, window)
 		grouping = slices.Clone(o.Indicator.LatencyNative.Grouping)
 		matchers = append(
 			cloneMatchers(o.Indicator.LatencyNative.Total.LabelMatchers),
@@ -71,6 +71,44 @@ func (o Objective) QueryTotal(window model.Duration) string {
 	return expr.String()
 }
 
+// QueryGroupingValues returns a PromQL query to get the distinct values of the grouping label,
+// one series per value of the objective's total metric.
+func (o Objective) QueryGroupingValues(label string) string {
+	expr, err := parser.ParseExpr(`count by (grouping) (metric{})`)
+	if err != nil {
+		return ""
+	}
+
+	var metric Metric
+	switch o.IndicatorType() {
+	case Ratio:
+		metric = o.Indicator.Ratio.Total
+	case Latency:
+		metric = o.Indicator.Latency.Total
+	case LatencyNative:
+		metric = o.Indicator.LatencyNative.Total
+	case BoolGauge:
+		metric = o.Indicator.BoolGauge.Metric
+	default:
+		return ""
+	}
+
+	matchers := cloneMatchers(metric.LabelMatchers)
+	for _, m := range matchers {
+		if m.Name == labels.MetricName {
+			m.Value = metric.Name
+		}
+	}
+
+	objectiveReplacer{
+		metric:   metric.Name,
+		matchers: matchers,
+		grouping: []string{label},
+	}.replace(expr)
+
+	return expr.String()
+}
+
 // QueryErrors returns a PromQL query to get the amount of request errors during the window.
 func (o Objective) QueryErrors(window model.Duration) string {
 	switch o.IndicatorType() {
@@ -80,7 +118,7 @@ func (o Objective) QueryErrors(window model.Duration) string {
 			return ""
 		}
 
-		metric := increaseName(o.Indicator.Ratio.Errors.Name, window)
+		metric := o.increaseName(o.Indicator.Ratio.Errors.Name, window)
 		matchers := cloneMatchers(o.Indicator.Ratio.Errors.LabelMatchers)
 
 		for _, m := range matchers {
@@ -108,7 +146,7 @@ func (o Objective) QueryErrors(window model.Duration) string {
 			return ""
 		}
 
-		metric := increaseName(o.Indicator.Latency.Total.Name, window)
+		metric := o.increaseName(o.Indicator.Latency.Total.Name, window)
 		matchers := cloneMatchers(o.Indicator.Latency.Total.LabelMatchers)
 		for _, m := range matchers {
 			if m.Name == labels.MetricName {
@@ -124,7 +162,7 @@ func (o Objective) QueryErrors(window model.Duration) string {
 			Value: o.Name(),
 		})
 
-		errorMetric := increaseName(o.Indicator.Latency.Success.Name, window)
+		errorMetric := o.increaseName(o.Indicator.Latency.Success.Name, window)
 		errorMatchers := cloneMatchers(o.Indicator.Latency.Success.LabelMatchers)
 		for _, m := range errorMatchers {
 			if m.Name == labels.MetricName {
@@ -153,7 +191,7 @@ func (o Objective) QueryErrors(window model.Duration) string {
 			return ""
 		}
 
-		metric := increaseName(o.Indicator.LatencyNative.Total.Name, window)
+		metric := o.increaseName(o.Indicator.LatencyNative.Total.Name, window)
 		matchers := cloneMatchers(o.Indicator.LatencyNative.Total.LabelMatchers)
 		for i, m := range matchers {
 			if m.Name == labels.MetricName {
@@ -255,7 +293,7 @@ func (o Objective) QueryErrorBudget() string {
 			return ""
 		}
 
-		metric := increaseName(o.Indicator.Ratio.Total.Name, o.Window)
+		metric := o.increaseName(o.Indicator.Ratio.Total.Name, o.Window)
 		matchers := cloneMatchers(o.Indicator.Ratio.Total.LabelMatchers)
 		for _, m := range matchers {
 			if m.Name == labels.MetricName {
@@ -269,7 +307,7 @@ func (o Objective) QueryErrorBudget() string {
 			Value: o.Name(),
 		})
 
-		errorMetric := increaseName(o.Indicator.Ratio.Errors.Name, o.Window)
+		errorMetric := o.increaseName(o.Indicator.Ratio.Errors.Name, o.Window)
 		errorMatchers := cloneMatchers(o.Indicator.Ratio.Errors.LabelMatchers)
 		for _, m := range errorMatchers {
 			if m.Name == labels.MetricName {
@@ -321,15 +359,15 @@ func (o Objective) QueryErrorBudget() string {
 		)
 		switch indicatorType {
 		case Latency:
-			metric = increaseName(o.Indicator.Latency.Total.Name, o.Window)
+			metric = o.increaseName(o.Indicator.Latency.Total.Name, o.Window)
 			matchers = cloneMatchers(o.Indicator.Latency.Total.LabelMatchers)
-			errorMetric = increaseName(o.Indicator.Latency.Success.Name, o.Window)
+			errorMetric = o.increaseName(o.Indicator.Latency.Success.Name, o.Window)
 			errorMatchers = cloneMatchers(o.Indicator.Latency.Success.LabelMatchers)
 			grouping = o.Indicator.Latency.Grouping
 		case LatencyNative:
-			metric = increaseName(o.Indicator.LatencyNative.Total.Name, o.Window)
+			metric = o.increaseName(o.Indicator.LatencyNative.Total.Name, o.Window)
 			matchers = cloneMatchers(o.Indicator.LatencyNative.Total.LabelMatchers)
-			errorMetric = increaseName(o.Indicator.LatencyNative.Total.Name, o.Window)
+			errorMetric = o.increaseName(o.Indicator.LatencyNative.Total.Name, o.Window)
 			errorMatchers = cloneMatchers(o.Indicator.LatencyNative.Total.LabelMatchers)
 			grouping = o.Indicator.LatencyNative.Grouping
 		}
@@ -433,6 +471,70 @@ func (o Objective) QueryErrorBudget() string {
 	}
 }
 
+// QueryErrorBudgetWindow returns a PromQL query to get the error budget left over the given window.
+// There are only recording rules for the objective's window,
+// therefore other windows are calculated from the raw metrics.
+func (o Objective) QueryErrorBudgetWindow(window model.Duration) string {
+	if window == o.Window {
+		return o.QueryErrorBudget()
+	}
+
+	var (
+		query         string
+		metric        string
+		matchers      []*labels.Matcher
+		errorMetric   string
+		errorMatchers []*labels.Matcher
+		grouping      []string
+		percentile    float64
+	)
+	switch o.IndicatorType() {
+	case Ratio:
+		query = `((1 - 0.696969) - (sum(increase(errorMetric{matchers="errors"}[1s]) or vector(0)) / sum(increase(metric{matchers="total"}[1s])))) / (1 - 0.696969)`
+		metric = o.Indicator.Ratio.Total.Name
+		matchers = cloneMatchers(o.Indicator.Ratio.Total.LabelMatchers)
+		errorMetric = o.Indicator.Ratio.Errors.Name
+		errorMatchers = cloneMatchers(o.Indicator.Ratio.Errors.LabelMatchers)
+	case Latency:
+		query = `((1 - 0.696969) - (1 - sum(increase(errorMetric{matchers="errors"}[1s]) or vector(0)) / sum(increase(metric{matchers="total"}[1s])))) / (1 - 0.696969)`
+		metric = o.Indicator.Latency.Total.Name
+		matchers = cloneMatchers(o.Indicator.Latency.Total.LabelMatchers)
+		errorMetric = o.Indicator.Latency.Success.Name
+		errorMatchers = cloneMatchers(o.Indicator.Latency.Success.LabelMatchers)
+	case LatencyNative:
+		query = `((1 - 0.696969) - (1 - histogram_fraction(0, 0.420, sum(increase(metric{matchers="total"}[1s]))))) / (1 - 0.696969)`
+		metric = o.Indicator.LatencyNative.Total.Name
+		matchers = cloneMatchers(o.Indicator.LatencyNative.Total.LabelMatchers)
+		percentile = time.Duration(o.Indicator.LatencyNative.Latency).Seconds()
+	case BoolGauge:
+		query = `((1 - 0.696969) - ((sum by (grouping) (count_over_time(metric{matchers="total"}[1s])) - sum by (grouping) (sum_over_time(metric{matchers="total"}[1s]))) / sum by (grouping) (count_over_time(metric{matchers="total"}[1s])))) / (1 - 0.696969)`
+		metric = o.Indicator.BoolGauge.Name
+		matchers = cloneMatchers(o.Indicator.BoolGauge.LabelMatchers)
+		grouping = o.Indicator.BoolGauge.Grouping
+	default:
+		return ""
+	}
+
+	expr, err := parser.ParseExpr(query)
+	if err != nil {
+		return ""
+	}
+
+	objectiveReplacer{
+		metric:        metric,
+		matchers:      matchers,
+		errorMetric:   errorMetric,
+		errorMatchers: errorMatchers,
+		grouping:      grouping,
+		window:        time.Duration(window),
+		target:        o.Target,
+		percentile:    percentile,
+		function:      o.function(),
+	}.replace(expr)
+
+	return expr.String()
+}
+
 func (o Objective) QueryBurnrate(timerange time.Duration, groupingMatchers []*labels.Matcher) (string, error) {
 	metric := ""
 	matchers := map[string]*labels.Matcher{}
@@ -531,6 +633,8 @@ type objectiveReplacer struct {
 	window        time.Duration
 	target        float64
 	percentile    float64
+	// function replaces rate and increase if set.
+	function string
 }
 
 func (r objectiveReplacer) replace(node parser.Node) {
@@ -541,6 +645,9 @@ func (r objectiveReplacer) replace(node parser.Node) {
 		}
 		r.replace(n.Expr)
 	case *parser.Call:
+		if r.function != "" && (n.Func.Name == "rate" || n.Func.Name == "increase") {
+			n.Func = parser.Functions[r.function]
+		}
 		r.replace(n.Args)
 	case parser.Expressions:
 		for _, expr := range n {
@@ -610,8 +717,9 @@ func (o Objective) RequestRange(timerange time.Duration) string {
 				o.Indicator.Ratio.Errors.LabelMatchers,
 				matchers,
 			),
-			window: timerange,
-			target: o.Target,
+			window:   timerange,
+			target:   o.Target,
+			function: o.function(),
 		}.replace(expr)
 
 		return expr.String()
@@ -627,6 +735,7 @@ func (o Objective) RequestRange(timerange time.Duration) string {
 			errorMetric:   o.Indicator.Latency.Success.Name,
 			errorMatchers: o.Indicator.Latency.Success.LabelMatchers,
 			window:        timerange,
+			function:      o.function(),
 		}.replace(expr)
 
 		return expr.String()
@@ -694,7 +803,8 @@ func (o Objective) ErrorsRange(timerange time.Duration) string {
 				errorMatchers,
 				matchers,
 			),
-			window: timerange,
+			window:   timerange,
+			function: o.function(),
 		}.replace(expr)
 
 		return expr.String()
@@ -710,6 +820,7 @@ func (o Objective) ErrorsRange(timerange time.Duration) string {
 			errorMetric:   o.Indicator.Latency.Success.Name,
 			errorMatchers: o.Indicator.Latency.Success.LabelMatchers,
 			window:        timerange,
+			function:      o.function(),
 		}.replace(expr)
 
 		return expr.String()
@@ -766,6 +877,7 @@ func (o Objective) DurationRange(timerange time.Duration, percentile float64) st
 			window:        timerange,
 			grouping:      []string{labels.BucketLabel},
 			percentile:    percentile,
+			function:      o.function(),
 		}.replace(expr)
 
 		return expr.String()
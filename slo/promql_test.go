@@ -58,6 +58,11 @@ var (
 		o.Indicator.Ratio.Errors.LabelMatchers = append(o.Indicator.Ratio.Errors.LabelMatchers, matcher)
 		return o
 	}
+	objectiveHTTPRatioFunction = func() Objective {
+		o := objectiveHTTPRatio()
+		o.Indicator.Ratio.Function = "avg_over_time"
+		return o
+	}
 	objectiveGRPCRatio = func() Objective {
 		return Objective{
 			Labels:      labels.FromStrings(labels.MetricName, "monitoring-grpc-errors"),
@@ -130,6 +135,11 @@ var (
 			},
 		}
 	}
+	objectiveHTTPLatencyFunction = func() Objective {
+		o := objectiveHTTPLatency()
+		o.Indicator.Latency.Function = "increase"
+		return o
+	}
 	objectiveHTTPNativeLatency = func() Objective {
 		o := objectiveHTTPLatency()
 		o.Indicator.Latency = nil
@@ -364,6 +374,10 @@ func TestObjective_QueryTotal(t *testing.T) {
 		name:      "http-ratio",
 		objective: objectiveHTTPRatio(),
 		expected:  `sum(http_requests:increase4w{job="thanos-receive-default",slo="monitoring-http-errors"})`,
+	}, {
+		name:      "http-ratio-function",
+		objective: objectiveHTTPRatioFunction(),
+		expected:  `sum(http_requests:avg_over_time4w{job="thanos-receive-default",slo="monitoring-http-errors"})`,
 	}, {
 		name:      "http-ratio-grouping",
 		objective: objectiveHTTPRatioGrouping(),
@@ -436,6 +450,40 @@ func TestObjective_QueryTotal(t *testing.T) {
 	}
 }
 
+func TestObjective_QueryGroupingValues(t *testing.T) {
+	testcases := []struct {
+		name      string
+		objective Objective
+		label     string
+		expected  string
+	}{{
+		name:      "http-ratio-grouping",
+		objective: objectiveHTTPRatioGrouping(),
+		label:     "handler",
+		expected:  `count by (handler) (http_requests_total{job="thanos-receive-default"})`,
+	}, {
+		name:      "http-latency-grouping",
+		objective: objectiveHTTPLatencyGrouping(),
+		label:     "job",
+		expected:  `count by (job) (http_request_duration_seconds_count{code=~"2..",job="metrics-service-thanos-receive-default"})`,
+	}, {
+		name:      "operator-ratio-grouping",
+		objective: objectiveOperatorGrouping(),
+		label:     "namespace",
+		expected:  `count by (namespace) (prometheus_operator_reconcile_operations_total)`,
+	}, {
+		name:      "up-targets-grouping-regex",
+		objective: objectiveUpTargetsGroupingRegex(),
+		label:     "instance",
+		expected:  `count by (instance) (up{instance!~"(127.0.0.1|localhost).*"})`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.objective.QueryGroupingValues(tc.label))
+		})
+	}
+}
+
 func TestObjective_QueryErrors(t *testing.T) {
 	testcases := []struct {
 		name      string
@@ -598,6 +646,50 @@ func TestObjective_QueryErrorBudget(t *testing.T) {
 	}
 }
 
+func TestObjective_QueryErrorBudgetWindow(t *testing.T) {
+	testcases := []struct {
+		name      string
+		objective Objective
+		window    model.Duration
+		expected  string
+	}{{
+		name:      "http-ratio-objective-window",
+		objective: objectiveHTTPRatio(),
+		window:    model.Duration(28 * 24 * time.Hour),
+		expected:  `((1 - 0.99) - (sum(http_requests:increase4w{code=~"5..",job="thanos-receive-default",slo="monitoring-http-errors"} or vector(0)) / sum(http_requests:increase4w{job="thanos-receive-default",slo="monitoring-http-errors"}))) / (1 - 0.99)`,
+	}, {
+		name:      "http-ratio",
+		objective: objectiveHTTPRatio(),
+		window:    model.Duration(24 * time.Hour),
+		expected:  `((1 - 0.99) - (sum(increase(http_requests_total{code=~"5..",job="thanos-receive-default"}[1d]) or vector(0)) / sum(increase(http_requests_total{job="thanos-receive-default"}[1d])))) / (1 - 0.99)`,
+	}, {
+		name:      "http-ratio-function",
+		objective: objectiveHTTPRatioFunction(),
+		window:    model.Duration(24 * time.Hour),
+		expected:  `((1 - 0.99) - (sum(avg_over_time(http_requests_total{code=~"5..",job="thanos-receive-default"}[1d]) or vector(0)) / sum(avg_over_time(http_requests_total{job="thanos-receive-default"}[1d])))) / (1 - 0.99)`,
+	}, {
+		name:      "http-latency",
+		objective: objectiveHTTPLatency(),
+		window:    model.Duration(24 * time.Hour),
+		expected:  `((1 - 0.995) - (1 - sum(increase(http_request_duration_seconds_bucket{code=~"2..",job="metrics-service-thanos-receive-default",le="1"}[1d]) or vector(0)) / sum(increase(http_request_duration_seconds_count{code=~"2..",job="metrics-service-thanos-receive-default"}[1d])))) / (1 - 0.995)`,
+	}, {
+		name:      "http-latency-native",
+		objective: objectiveHTTPNativeLatency(),
+		window:    model.Duration(24 * time.Hour),
+		expected:  `((1 - 0.995) - (1 - histogram_fraction(0, 1, sum(increase(http_request_duration_seconds{code=~"2..",job="metrics-service-thanos-receive-default"}[1d]))))) / (1 - 0.995)`,
+	}, {
+		name:      "up-targets-grouping-regex",
+		objective: objectiveUpTargetsGroupingRegex(),
+		window:    model.Duration(24 * time.Hour),
+		expected:  `((1 - 0.99) - ((sum by (job, instance) (count_over_time(up{instance!~"(127.0.0.1|localhost).*"}[1d])) - sum by (job, instance) (sum_over_time(up{instance!~"(127.0.0.1|localhost).*"}[1d]))) / sum by (job, instance) (count_over_time(up{instance!~"(127.0.0.1|localhost).*"}[1d])))) / (1 - 0.99)`,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.objective.QueryErrorBudgetWindow(tc.window))
+		})
+	}
+}
+
 func TestObjective_QueryBurnrate(t *testing.T) {
 	testcases := []struct {
 		name      string
@@ -714,6 +806,11 @@ func TestObjective_RequestRange(t *testing.T) {
 		objective: objectiveHTTPRatio(),
 		timerange: 6 * time.Hour,
 		expected:  `sum by (code) (rate(http_requests_total{job="thanos-receive-default"}[6h])) > 0`,
+	}, {
+		name:      "http-ratio-function",
+		objective: objectiveHTTPRatioFunction(),
+		timerange: 6 * time.Hour,
+		expected:  `sum by (code) (avg_over_time(http_requests_total{job="thanos-receive-default"}[6h])) > 0`,
 	}, {
 		name:      "http-ratio-grouping",
 		objective: objectiveHTTPRatioGrouping(),
@@ -813,6 +910,11 @@ func TestObjective_ErrorsRange(t *testing.T) {
 		objective: objectiveHTTPRatio(),
 		timerange: 6 * time.Hour,
 		expected:  `sum by (code) (rate(http_requests_total{code=~"5..",job="thanos-receive-default"}[6h])) / scalar(sum(rate(http_requests_total{job="thanos-receive-default"}[6h]))) > 0`,
+	}, {
+		name:      "http-ratio-function",
+		objective: objectiveHTTPRatioFunction(),
+		timerange: 6 * time.Hour,
+		expected:  `sum by (code) (avg_over_time(http_requests_total{code=~"5..",job="thanos-receive-default"}[6h])) / scalar(sum(avg_over_time(http_requests_total{job="thanos-receive-default"}[6h]))) > 0`,
 	}, {
 		name:      "http-ratio-grouping",
 		objective: objectiveHTTPRatioGrouping(),
@@ -938,6 +1040,11 @@ func TestObjective_DurationRange(t *testing.T) {
 		objective: objectiveHTTPLatency(),
 		timerange: time.Hour,
 		expected:  `histogram_quantile(0.95, sum by (le) (rate(http_request_duration_seconds_bucket{code=~"2..",job="metrics-service-thanos-receive-default"}[1h])))`,
+	}, {
+		name:      "http-latency-function",
+		objective: objectiveHTTPLatencyFunction(),
+		timerange: time.Hour,
+		expected:  `histogram_quantile(0.95, sum by (le) (increase(http_request_duration_seconds_bucket{code=~"2..",job="metrics-service-thanos-receive-default"}[1h])))`,
 	}, {
 		name:      "http-latency-native",
 		objective: objectiveHTTPNativeLatency(),
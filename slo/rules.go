@@ -29,6 +29,9 @@ type MultiBurnRateAlert struct {
 }
 
 func (o Objective) Alerts() ([]MultiBurnRateAlert, error) {
+	if err := o.ValidateWindows(); err != nil {
+		return nil, err
+	}
 	ws := Windows(time.Duration(o.Window))
 
 	mbras := make([]MultiBurnRateAlert, len(ws))
@@ -57,6 +60,9 @@ func (o Objective) Alerts() ([]MultiBurnRateAlert, error) {
 }
 
 func (o Objective) Burnrates() (monitoringv1.RuleGroup, error) {
+	if err := o.ValidateWindows(); err != nil {
+		return monitoringv1.RuleGroup{}, err
+	}
 	sloName := o.Labels.Get(labels.MetricName)
 
 	ws := Windows(time.Duration(o.Window))
@@ -472,6 +478,7 @@ func (o Objective) Burnrate(timerange time.Duration) string {
 			errorMatchers: o.Indicator.Ratio.Errors.LabelMatchers,
 			grouping:      grouping,
 			window:        timerange,
+			function:      o.function(),
 		}.replace(expr)
 
 		return expr.String()
@@ -508,6 +515,7 @@ func (o Objective) Burnrate(timerange time.Duration) string {
 			errorMatchers: o.Indicator.Latency.Success.LabelMatchers,
 			grouping:      grouping,
 			window:        timerange,
+			function:      o.function(),
 		}.replace(expr)
 
 		return expr.String()
@@ -584,11 +592,17 @@ func countName(metric string, window model.Duration) string {
 	return fmt.Sprintf("%s:count%s", metric, window)
 }
 
-func increaseName(metric string, window model.Duration) string {
+// increaseName returns the name of the recording rule applying the indicator's function to the metric over the window.
+// The function is part of the name, e.g. metric:avg_over_time4w, to not mix the rules' series when it changes.
+func (o Objective) increaseName(metric string, window model.Duration) string {
 	metric = strings.TrimSuffix(metric, "_total")
 	metric = strings.TrimSuffix(metric, "_count")
 	metric = strings.TrimSuffix(metric, "_bucket")
-	return fmt.Sprintf("%s:increase%s", metric, window)
+	function := o.function()
+	if function == "" {
+		function = "increase"
+	}
+	return fmt.Sprintf("%s:%s%s", metric, function, window)
 }
 
 func (o Objective) commonRuleLabels(sloName string) map[string]string {
@@ -685,10 +699,11 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 			matchers: o.Indicator.Ratio.Total.LabelMatchers,
 			grouping: grouping,
 			window:   time.Duration(o.Window),
+			function: o.function(),
 		}.replace(expr)
 
 		rules = append(rules, monitoringv1.Rule{
-			Record: increaseName(o.Indicator.Ratio.Total.Name, o.Window),
+			Record: o.increaseName(o.Indicator.Ratio.Total.Name, o.Window),
 			Expr:   intstr.FromString(expr.String()),
 			Labels: ruleLabels,
 		})
@@ -734,10 +749,11 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 				matchers: o.Indicator.Ratio.Errors.LabelMatchers,
 				grouping: grouping,
 				window:   time.Duration(o.Window),
+				function: o.function(),
 			}.replace(expr)
 
 			rules = append(rules, monitoringv1.Rule{
-				Record: increaseName(o.Indicator.Ratio.Errors.Name, o.Window),
+				Record: o.increaseName(o.Indicator.Ratio.Errors.Name, o.Window),
 				Expr:   intstr.FromString(expr.String()),
 				Labels: ruleLabels,
 			})
@@ -809,10 +825,11 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 			matchers: o.Indicator.Latency.Total.LabelMatchers,
 			grouping: grouping,
 			window:   time.Duration(o.Window),
+			function: o.function(),
 		}.replace(expr)
 
 		rules = append(rules, monitoringv1.Rule{
-			Record: increaseName(o.Indicator.Latency.Total.Name, o.Window),
+			Record: o.increaseName(o.Indicator.Latency.Total.Name, o.Window),
 			Expr:   intstr.FromString(expr.String()),
 			Labels: ruleLabels,
 		})
@@ -827,6 +844,7 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 			matchers: o.Indicator.Latency.Success.LabelMatchers,
 			grouping: grouping,
 			window:   time.Duration(o.Window),
+			function: o.function(),
 		}.replace(expr)
 
 		var le string
@@ -842,7 +860,7 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 		}
 
 		rules = append(rules, monitoringv1.Rule{
-			Record: increaseName(o.Indicator.Latency.Success.Name, o.Window),
+			Record: o.increaseName(o.Indicator.Latency.Success.Name, o.Window),
 			Expr:   intstr.FromString(expr.String()),
 			Labels: ruleLabelsLe,
 		})
@@ -924,7 +942,7 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 		}.replace(expr)
 
 		rules = append(rules, monitoringv1.Rule{
-			Record: increaseName(o.Indicator.LatencyNative.Total.Name, o.Window),
+			Record: o.increaseName(o.Indicator.LatencyNative.Total.Name, o.Window),
 			Expr:   intstr.FromString(expr.String()),
 			Labels: ruleLabels,
 		})
@@ -947,7 +965,7 @@ func (o Objective) IncreaseRules() (monitoringv1.RuleGroup, error) {
 		ruleLabels["le"] = fmt.Sprintf("%g", latencySeconds)
 
 		rules = append(rules, monitoringv1.Rule{
-			Record: increaseName(o.Indicator.LatencyNative.Total.Name, o.Window),
+			Record: o.increaseName(o.Indicator.LatencyNative.Total.Name, o.Window),
 			Expr:   intstr.FromString(expr.String()),
 			Labels: ruleLabels,
 		})
@@ -1127,6 +1145,30 @@ func Windows(sloWindow time.Duration) []Window {
 	}}
 }
 
+// Validate returns an error if the window's burn rates can't be alerted on.
+// The short window must be positive and strictly shorter than the long window,
+// otherwise the multi burn rate alerts don't make sense.
+func (w Window) Validate() error {
+	if w.Short <= 0 {
+		return fmt.Errorf("short window %s must be positive", w.Short)
+	}
+	if w.Short >= w.Long {
+		return fmt.Errorf("short window %s must be shorter than long window %s", w.Short, w.Long)
+	}
+	return nil
+}
+
+// ValidateWindows returns an error for the first of the objective's windows that isn't valid,
+// e.g. because the objective's window is too short for the short windows to round to a minute.
+func (o Objective) ValidateWindows() error {
+	for i, w := range o.Windows() {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("window %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
 func burnratesFromWindows(ws []Window) []time.Duration {
 	dedup := map[time.Duration]bool{}
 	for _, w := range ws {
@@ -1175,7 +1217,7 @@ func (o Objective) GenericRules() (monitoringv1.RuleGroup, error) {
 			return monitoringv1.RuleGroup{}, err
 		}
 
-		totalIncreaseName := increaseName(o.Indicator.Ratio.Total.Name, o.Window)
+		totalIncreaseName := o.increaseName(o.Indicator.Ratio.Total.Name, o.Window)
 
 		// Copy the list of matchers to modify them
 		totalMatchers := make([]*labels.Matcher, 0, len(o.Indicator.Ratio.Total.LabelMatchers))
@@ -1197,7 +1239,7 @@ func (o Objective) GenericRules() (monitoringv1.RuleGroup, error) {
 			Value: o.Name(),
 		})
 
-		errorsIncreaseName := increaseName(o.Indicator.Ratio.Errors.Name, o.Window)
+		errorsIncreaseName := o.increaseName(o.Indicator.Ratio.Errors.Name, o.Window)
 
 		errorMatchers := make([]*labels.Matcher, 0, len(o.Indicator.Ratio.Errors.LabelMatchers))
 		for _, m := range o.Indicator.Ratio.Errors.LabelMatchers {
@@ -1277,7 +1319,7 @@ func (o Objective) GenericRules() (monitoringv1.RuleGroup, error) {
 				return monitoringv1.RuleGroup{}, err
 			}
 
-			metric := increaseName(o.Indicator.Latency.Total.Name, o.Window)
+			metric := o.increaseName(o.Indicator.Latency.Total.Name, o.Window)
 			matchers := o.Indicator.Latency.Total.LabelMatchers
 			for _, m := range matchers {
 				if m.Name == labels.MetricName {
@@ -1292,7 +1334,7 @@ func (o Objective) GenericRules() (monitoringv1.RuleGroup, error) {
 				Value: o.Name(),
 			})
 
-			errorMetric := increaseName(o.Indicator.Latency.Success.Name, o.Window)
+			errorMetric := o.increaseName(o.Indicator.Latency.Success.Name, o.Window)
 			errorMatchers := o.Indicator.Latency.Success.LabelMatchers
 			for _, m := range errorMatchers {
 				if m.Name == labels.MetricName {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -1267,6 +1268,23 @@ func TestObjective_IncreaseRules(t *testing.T) {
 				Labels: map[string]string{"job": "thanos-receive-default", "slo": "monitoring-http-errors", "severity": "critical"},
 			}},
 		},
+	}, {
+		name: "http-ratio-function",
+		slo:  objectiveHTTPRatioFunction(),
+		rules: monitoringv1.RuleGroup{
+			Name:     "monitoring-http-errors-increase",
+			Interval: monitoringDuration("2m30s"),
+			Rules: []monitoringv1.Rule{{
+				Record: "http_requests:avg_over_time4w",
+				Expr:   intstr.FromString(`sum by (code) (avg_over_time(http_requests_total{job="thanos-receive-default"}[4w]))`),
+				Labels: map[string]string{"job": "thanos-receive-default", "slo": "monitoring-http-errors"},
+			}, {
+				Alert:  "SLOMetricAbsent",
+				Expr:   intstr.FromString(`absent(http_requests_total{job="thanos-receive-default"}) == 1`),
+				For:    monitoringDuration("2m"),
+				Labels: map[string]string{"job": "thanos-receive-default", "slo": "monitoring-http-errors", "severity": "critical"},
+			}},
+		},
 	}, {
 		name: "http-ratio-grouping",
 		slo:  objectiveHTTPRatioGrouping(),
@@ -1625,7 +1643,7 @@ func TestObjective_IncreaseRules(t *testing.T) {
 		},
 	}}
 
-	require.Len(t, testcases, 17)
+	require.Len(t, testcases, 18)
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1672,6 +1690,28 @@ func Test_windows(t *testing.T) {
 	}, ws[3])
 }
 
+func TestWindow_Validate(t *testing.T) {
+	require.NoError(t, Window{Short: 5 * time.Minute, Long: time.Hour}.Validate())
+	require.NoError(t, Window{Short: time.Minute, Long: time.Minute + time.Second}.Validate())
+	require.EqualError(t, Window{Short: time.Hour, Long: time.Hour}.Validate(), "short window 1h0m0s must be shorter than long window 1h0m0s")
+	require.EqualError(t, Window{Short: 2 * time.Hour, Long: time.Hour}.Validate(), "short window 2h0m0s must be shorter than long window 1h0m0s")
+	require.EqualError(t, Window{Short: 0, Long: time.Hour}.Validate(), "short window 0s must be positive")
+	require.EqualError(t, Window{Short: 0, Long: 0}.Validate(), "short window 0s must be positive")
+}
+
+func TestObjective_ValidateWindows(t *testing.T) {
+	o := objectiveHTTPRatio()
+	require.NoError(t, o.ValidateWindows())
+
+	// The windows of a single day round to 0s short windows, which can't be alerted on.
+	o.Window = model.Duration(24 * time.Hour)
+	require.EqualError(t, o.ValidateWindows(), "window 0: short window 0s must be positive")
+	_, err := o.Alerts()
+	require.EqualError(t, err, "window 0: short window 0s must be positive")
+	_, err = o.Burnrates()
+	require.EqualError(t, err, "window 0: short window 0s must be positive")
+}
+
 func TestObjective_GrafanaRules(t *testing.T) {
 	testcases := []struct {
 		name  string
@@ -55,6 +55,12 @@ func (o Objective) Exhausts(factor float64) model.Duration {
 	return model.Duration(time.Second * time.Duration(time.Duration(o.Window).Seconds()/factor))
 }
 
+// ErrorBudget returns the error budget as a duration of the objective's window,
+// e.g. 43m12s for a target of 99.9% over 30d.
+func (o Objective) ErrorBudget() time.Duration {
+	return time.Duration((1 - o.Target) * float64(o.Window)).Round(time.Millisecond)
+}
+
 type IndicatorType int
 
 const (
@@ -123,12 +129,36 @@ type RatioIndicator struct {
 	Errors   Metric
 	Total    Metric
 	Grouping []string
+	// Function applied to the metrics over time, see Functions. Empty uses rate and increase for counters.
+	Function string
 }
 
 type LatencyIndicator struct {
 	Success  Metric
 	Total    Metric
 	Grouping []string
+	// Function applied to the metrics over time, see Functions. Empty uses rate and increase for counters.
+	Function string
+}
+
+// Functions are the functions ratio and latency indicators can apply to their metrics over time.
+// By default counters are queried with rate and increase. Any other function replaces both,
+// e.g. avg_over_time for gauges. The recording rules are named after the function, e.g. metric:avg_over_time4w.
+var Functions = []string{"rate", "increase", "avg_over_time", "sum_over_time"}
+
+// function returns the function the indicator applies to its metrics over time, or empty for the default rate and increase.
+func (o Objective) function() string {
+	var function string
+	switch {
+	case o.Indicator.Ratio != nil:
+		function = o.Indicator.Ratio.Function
+	case o.Indicator.Latency != nil:
+		function = o.Indicator.Latency.Function
+	}
+	if function == "rate" {
+		return ""
+	}
+	return function
 }
 
 type LatencyNativeIndicator struct {
@@ -0,0 +1,30 @@
+package slo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjective_ErrorBudget(t *testing.T) {
+	testcases := []struct {
+		target   float64
+		window   time.Duration
+		expected time.Duration
+	}{
+		{target: 0.999, window: 30 * 24 * time.Hour, expected: 43*time.Minute + 12*time.Second},
+		{target: 0.99, window: 28 * 24 * time.Hour, expected: 6*time.Hour + 43*time.Minute + 12*time.Second},
+		{target: 0.995, window: 7 * 24 * time.Hour, expected: 50*time.Minute + 24*time.Second},
+		{target: 0.9999, window: 90 * 24 * time.Hour, expected: 12*time.Minute + 57*time.Second + 600*time.Millisecond},
+		{target: 0.99999, window: 24 * time.Hour, expected: 864 * time.Millisecond},
+		{target: 1, window: 30 * 24 * time.Hour, expected: 0},
+	}
+	for _, tc := range testcases {
+		t.Run(model.Duration(tc.window).String(), func(t *testing.T) {
+			o := Objective{Target: tc.target, Window: model.Duration(tc.window)}
+			require.Equal(t, tc.expected, o.ErrorBudget())
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer uses the global tracer provider, which doesn't record any spans unless setupTracing configured an exporter.
+var tracer = otel.Tracer("github.com/pyrra-dev/pyrra")
+
+// setupTracing configures the global tracer provider to export spans to the OTLP HTTP endpoint.
+// Tracing stays disabled if the endpoint is empty.
+// The returned function flushes the remaining spans and must be called on shutdown.
+func setupTracing(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	if insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName("pyrra")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// newTracingInterceptor starts a span for each connect request, named after the procedure.
+// Handlers continue traces propagated by their callers and clients propagate their traces to the backend.
+func newTracingInterceptor() connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			kind := trace.SpanKindServer
+			if req.Spec().IsClient {
+				kind = trace.SpanKindClient
+			} else {
+				ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(req.Header()))
+			}
+
+			ctx, span := tracer.Start(ctx, req.Spec().Procedure, trace.WithSpanKind(kind))
+			defer span.End()
+
+			if req.Spec().IsClient {
+				otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header()))
+			}
+
+			resp, err := next(ctx, req)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				span.SetAttributes(attribute.String("rpc.connect.code", connect.CodeOf(err).String()))
+			}
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestPromCacheTracing(t *testing.T) {
+	shutdown, err := setupTracing(context.Background(), "", false)
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	previous := otel.GetTracerProvider()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	api := &fakePrometheusAPI{value: func(_ string, _ time.Time) model.Value {
+		return model.Vector{{Value: 1}}
+	}}
+	pc := newTestPromCache(t, api)
+	ctx := contextSetPromCache(context.Background(), time.Hour)
+	ts := time.Unix(1_700_000_000, 0)
+
+	_, _, err = pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+	pc.cache.Wait()
+	_, _, err = pc.Query(ctx, "up", ts)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	for i, hit := range []bool{false, true} {
+		require.Equal(t, "promCache.Query", spans[i].Name())
+		require.Contains(t, spans[i].Attributes(), attribute.String("query", "up"))
+		require.Contains(t, spans[i].Attributes(), attribute.Bool("cache.hit", hit))
+	}
+}
@@ -17,6 +17,10 @@ export const PATH_PREFIX: string = window.PATH_PREFIX
 export const API_BASEPATH: string = window.API_BASEPATH
 // @ts-expect-error - this is passed from the HTML template.
 export const PROMETHEUS_URL: string = window.PROMETHEUS_URL
+// @ts-expect-error - this is passed from the HTML template.
+export const TITLE: string = window.TITLE
+// @ts-expect-error - this is passed from the HTML template.
+export const LOGO_URL: string = window.LOGO_URL
 
 const queryClient = new QueryClient({
   defaultOptions: {
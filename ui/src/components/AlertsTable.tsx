@@ -18,6 +18,7 @@ import {usePrometheusQueryRange} from '../prometheus'
 import {step} from './graphs/step'
 import {convertAlignedData} from './graphs/aligneddata'
 import {formatDuration} from '../duration'
+import QueryWarnings from './QueryWarnings'
 
 interface AlertsTableProps {
   client: PromiseClient<typeof ObjectiveService>
@@ -41,6 +42,7 @@ const AlertsTable = ({
   uPlotCursor,
 }: AlertsTableProps): JSX.Element => {
   const [alerts, setAlerts] = useState<Alert[]>([])
+  const [warnings, setWarnings] = useState<string[]>([])
   const [showBurnrate, setShowBurnrate] = useState<boolean[]>([false, false, false, false])
 
   useEffect(() => {
@@ -59,6 +61,7 @@ const AlertsTable = ({
       })
       .then((resp: GetAlertsResponse) => {
         setAlerts(resp.alerts)
+        setWarnings(resp.warnings)
       })
       .catch((err) => console.log(err))
   }, [client, objective, grouping])
@@ -78,6 +81,7 @@ const AlertsTable = ({
 
   return (
     <div className="table-responsive">
+      <QueryWarnings warnings={warnings} />
       <Table className="table-alerts">
         <thead>
           <tr>
@@ -98,20 +102,21 @@ const AlertsTable = ({
           {alerts.map((a: Alert, i: number) => {
             // TODO: Refactor all of this to read the current value from alertsSeries
             let shortCurrent = ''
-            if (a.short?.current === -1.0) {
-              shortCurrent = 'NaN'
+            if (a.short?.error !== undefined && a.short.error !== '') {
+              shortCurrent = 'failed'
             } else if (a.short?.current === undefined) {
-              shortCurrent = (0).toFixed(3).toString()
+              // The current burn rate is unknown, e.g. without any data.
+              shortCurrent = 'NaN'
             } else {
               shortCurrent = a.short.current.toFixed(3)
             }
             let longCurrent = ''
-            if (a.long?.current === -1.0) {
-              longCurrent = 'NaN'
+            if (a.long?.error !== undefined && a.long.error !== '') {
+              longCurrent = 'failed'
             } else if (a.long?.current === undefined) {
-              longCurrent = (0).toFixed(3).toString()
+              longCurrent = 'NaN'
             } else {
-              longCurrent = a.long?.current.toFixed(3)
+              longCurrent = a.long.current.toFixed(3)
             }
 
             const seriesFiringIndex = alertsLabels.findIndex((al: Labels): boolean => {
@@ -176,19 +181,19 @@ const AlertsTable = ({
                           {a.factor} * (1 - {objective.target})
                         </OverlayTooltip>
                       }>
-                      <span>{(a.factor * (1 - objective?.target)).toFixed(3)}</span>
+                      <span>{a.threshold.toFixed(3)}</span>
                     </OverlayTrigger>
                   </td>
                   <td style={{textAlign: 'center'}}>
                     <small style={{opacity: 0.5}}>&gt;</small>
                   </td>
-                  <td style={{textAlign: 'left'}}>
+                  <td style={{textAlign: 'left'}} title={a.short?.error}>
                     {shortCurrent} ({formatDuration(Number(a.short?.window?.seconds) * 1000)})
                   </td>
                   <td style={{textAlign: 'left'}}>
                     <small style={{opacity: 0.5}}>and</small>
                   </td>
-                  <td style={{textAlign: 'left'}}>
+                  <td style={{textAlign: 'left'}} title={a.long?.error}>
                     {longCurrent} ({formatDuration(Number(a.long?.window?.seconds) * 1000)})
                   </td>
                   <td style={{textAlign: 'right'}}>
@@ -213,7 +218,7 @@ const AlertsTable = ({
                       <BurnrateGraph
                         client={promClient}
                         alert={a}
-                        threshold={a.factor * (1 - objective.target)}
+                        threshold={a.threshold}
                         from={from}
                         to={to}
                         pendingData={pendingAlignedData}
@@ -2,6 +2,7 @@ import {ReactNode} from 'react'
 import {Col, Container, Navbar as BootstrapNavbar} from 'react-bootstrap'
 import {Link} from 'react-router-dom'
 import logo from '../logo.svg'
+import {LOGO_URL} from '../App'
 
 interface NavbarProps {
   children?: ReactNode
@@ -20,7 +21,7 @@ const Navbar = ({children}: NavbarProps): JSX.Element => {
         <></>
       )}
       <Link to="/" className="logo">
-        <img src={logo} alt="" height={40} />
+        <img src={LOGO_URL !== '' ? LOGO_URL : logo} alt="" height={40} />
       </Link>
     </BootstrapNavbar>
   )
@@ -0,0 +1,28 @@
+import React from 'react'
+import {Alert} from 'react-bootstrap'
+
+interface QueryWarningsProps {
+  warnings: string[]
+}
+
+// QueryWarnings shows the warnings returned by Prometheus, e.g. if Thanos returned a partial response.
+const QueryWarnings = ({warnings}: QueryWarningsProps): JSX.Element => {
+  if (warnings.length === 0) {
+    return <></>
+  }
+
+  const unique = Array.from(new Set(warnings))
+
+  return (
+    <Alert variant="warning" className="query-warnings">
+      The data might be incomplete:
+      <ul className="mb-0">
+        {unique.map((w: string) => (
+          <li key={w}>{w}</li>
+        ))}
+      </ul>
+    </Alert>
+  )
+}
+
+export default QueryWarnings
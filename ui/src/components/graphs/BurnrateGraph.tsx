@@ -11,6 +11,7 @@ import {seriesGaps} from './gaps'
 import {blues, greys, reds} from './colors'
 import {Alert} from '../../proto/objectives/v1alpha1/objectives_pb'
 import {formatDuration} from '../../duration'
+import QueryWarnings from '../QueryWarnings'
 
 interface BurnrateGraphProps {
   client: PromiseClient<typeof PrometheusService>
@@ -196,6 +197,9 @@ const BurnrateGraph = ({
           <i style={{color: firingColor}}>firing</i>.
         </p>
       </div>
+      <QueryWarnings
+        warnings={[...(shortResponse?.warnings ?? []), ...(longResponse?.warnings ?? [])]}
+      />
       <UplotReact
         options={{
           width: width - (2 * 10 + 2 * 15), // margin and padding
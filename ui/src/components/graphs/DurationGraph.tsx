@@ -17,6 +17,7 @@ import {
 } from '../../proto/objectives/v1alpha1/objectives_pb'
 import {selectTimeRange} from './selectTimeRange'
 import {formatDuration} from '../../duration'
+import QueryWarnings from '../QueryWarnings'
 
 interface DurationGraphProps {
   client: PromiseClient<typeof ObjectiveService>
@@ -47,6 +48,7 @@ const DurationGraph = ({
   const [durationQueries, setDurationQueries] = useState<string[]>([])
   const [durationLabels, setDurationLabels] = useState<string[]>([])
   const [durationsLoading, setDurationsLoading] = useState<boolean>(true)
+  const [warnings, setWarnings] = useState<string[]>([])
   const [width, setWidth] = useState<number>(500)
 
   const setWidthFromContainer = () => {
@@ -71,7 +73,7 @@ const DurationGraph = ({
       })
       .then((resp: GraphDurationResponse) => {
         let durationTimestamps: number[] = []
-        const durationData: number[][] = []
+        const durationData: Array<Array<number | null>> = []
         const durationLabels: string[] = []
         const durationQueries: string[] = []
 
@@ -89,7 +91,8 @@ const DurationGraph = ({
           }
 
           series.forEach((s: Series) => {
-            durationData.push(s.values)
+            // NaN values are gaps in the series and uPlot expects them to be null.
+            durationData.push(s.values.map((v: number) => (isNaN(v) ? null : v)))
           })
 
           durationLabels.push(...timeseries.labels)
@@ -98,9 +101,11 @@ const DurationGraph = ({
         setDurations([durationTimestamps, ...durationData])
         setDurationLabels(durationLabels)
         setDurationQueries(durationQueries)
+        setWarnings(resp.timeseries.flatMap((timeseries: Timeseries) => timeseries.warnings))
       })
       .catch(() => {
         setDurations(undefined)
+        setWarnings([])
       })
       .finally(() => {
         setDurationsLoading(false)
@@ -157,6 +162,7 @@ const DurationGraph = ({
           )}
         </p>
       </div>
+      <QueryWarnings warnings={warnings} />
 
       <div ref={targetRef}>
         {durations !== undefined ? (
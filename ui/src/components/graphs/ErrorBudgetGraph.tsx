@@ -13,6 +13,7 @@ import {usePrometheusQueryRange} from '../../prometheus'
 import {SamplePair, SampleStream} from '../../proto/prometheus/v1/prometheus_pb'
 import {selectTimeRange} from './selectTimeRange'
 import {formatDuration} from '../../duration'
+import QueryWarnings from '../QueryWarnings'
 
 interface ErrorBudgetGraphProps {
   client: PromiseClient<typeof PrometheusService>
@@ -67,11 +68,12 @@ const ErrorBudgetGraph = ({
   if (status === 'success') {
     if (response?.options.case === 'matrix') {
       const times: number[] = []
-      const values: number[] = []
+      const values: Array<number | null> = []
       response.options.value.samples.forEach((s: SampleStream) => {
         s.values.forEach((sp: SamplePair) => {
           times.push(Number(sp.time))
-          values.push(sp.value * 100)
+          // Show missing data as gap instead of 0% error budget left.
+          values.push(isNaN(sp.value) ? null : sp.value * 100)
         })
       })
       samples = [times, values]
@@ -159,6 +161,7 @@ const ErrorBudgetGraph = ({
       <div>
         <p>What percentage of the error budget is left over time?</p>
       </div>
+      <QueryWarnings warnings={response?.warnings ?? []} />
 
       <div ref={targetRef}>
         {samples.length > 0 ? (
@@ -14,6 +14,7 @@ import {convertAlignedData} from './aligneddata'
 import {selectTimeRange} from './selectTimeRange'
 import {Labels, labelValues} from '../../labels'
 import {formatDuration} from '../../duration'
+import QueryWarnings from '../QueryWarnings'
 
 interface ErrorsGraphProps {
   client: PromiseClient<typeof PrometheusService>
@@ -133,6 +134,7 @@ const ErrorsGraph = ({
       <div>
         <p>{description}</p>
       </div>
+      <QueryWarnings warnings={response?.warnings ?? []} />
 
       <div ref={targetRef}>
         <UplotReact
@@ -14,6 +14,7 @@ import {convertAlignedData} from './aligneddata'
 import {selectTimeRange} from './selectTimeRange'
 import {Labels, labelValues} from '../../labels'
 import {formatDuration} from '../../duration'
+import QueryWarnings from '../QueryWarnings'
 
 interface RequestsGraphProps {
   client: PromiseClient<typeof PrometheusService>
@@ -123,6 +124,7 @@ const RequestsGraph = ({
       <div>
         <p>{description}</p>
       </div>
+      <QueryWarnings warnings={response?.warnings ?? []} />
 
       <div ref={targetRef}>
         {data.length > 0 ? (
@@ -11,7 +11,7 @@ import {
   Spinner,
   Tooltip as OverlayTooltip,
 } from 'react-bootstrap'
-import {API_BASEPATH, hasObjectiveType, latencyTarget, ObjectiveType} from '../App'
+import {API_BASEPATH, hasObjectiveType, latencyTarget, ObjectiveType, TITLE} from '../App'
 import Navbar from '../components/Navbar'
 import {MetricName, parseLabels} from '../labels'
 import ErrorBudgetGraph from '../components/graphs/ErrorBudgetGraph'
@@ -33,6 +33,7 @@ import ObjectiveTile from '../components/tiles/ObjectiveTile'
 import AvailabilityTile from '../components/tiles/AvailabilityTile'
 import ErrorBudgetTile from '../components/tiles/ErrorBudgetTile'
 import Tiles from '../components/tiles/Tiles'
+import QueryWarnings from '../components/QueryWarnings'
 import {IconChartArea, IconChartLine} from '../components/Icons'
 
 const Detail = () => {
@@ -83,7 +84,7 @@ const Detail = () => {
       }
     }
 
-    document.title = `${name} - Pyrra`
+    document.title = `${name} - ${TITLE}`
 
     return {from, to, expr, grouping, groupingExpr, groupingLabels, name, labels}
   }, [search])
@@ -265,6 +266,9 @@ const Detail = () => {
           </Row>
           <Row>
             <Col className="col-xxxl-10 offset-xxxl-1">
+              <QueryWarnings
+                warnings={[...(totalResponse?.warnings ?? []), ...(errorResponse?.warnings ?? [])]}
+              />
               <Tiles>
                 <ObjectiveTile objective={objective} />
                 <AvailabilityTile
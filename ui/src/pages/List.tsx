@@ -11,9 +11,10 @@ import {
   Table,
   Tooltip as OverlayTooltip,
 } from 'react-bootstrap'
-import {API_BASEPATH, latencyTarget} from '../App'
+import {API_BASEPATH, latencyTarget, TITLE} from '../App'
 import {useLocation, useNavigate} from 'react-router-dom'
 import Navbar from '../components/Navbar'
+import QueryWarnings from '../components/QueryWarnings'
 import {Labels, labelsString, MetricName, parseLabels} from '../labels'
 import {createConnectTransport} from '@bufbuild/connect-web'
 import {createPromiseClient} from '@connectrpc/connect'
@@ -22,9 +23,10 @@ import {
   Alert as ObjectiveAlert,
   Alert_State,
   GetAlertsResponse,
-  GetStatusResponse,
+  ListObjectiveStatusesResponse,
   Objective,
   ObjectiveStatus,
+  ObjectiveStatuses,
 } from '../proto/objectives/v1alpha1/objectives_pb'
 import {formatDuration} from '../duration'
 import {
@@ -70,6 +72,7 @@ interface TableObjective {
   budget?: number | null
   severity: string | null
   latency: number | undefined
+  warnings?: string[]
 }
 
 interface TableAvailability {
@@ -144,6 +147,7 @@ const tableReducer = (state: TableState, action: TableAction): TableState => {
               percentage: action.status.availability?.percentage ?? 0,
             },
             budget: action.status.budget?.remaining,
+            warnings: action.status.warnings,
           },
         },
       }
@@ -170,6 +174,7 @@ const tableReducer = (state: TableState, action: TableAction): TableState => {
               percentage: action.status.availability?.percentage ?? 0,
             },
             budget: action.status.budget?.remaining,
+            warnings: action.status.warnings,
             latency: latencyTarget(action.objective),
           },
         },
@@ -422,7 +427,7 @@ const emptyData: row[] = []
 const List = () => {
   console.log('render List')
 
-  document.title = 'Objectives - Pyrra'
+  document.title = `Objectives - ${TITLE}`
   const navigate = useNavigate()
   const {search} = useLocation()
 
@@ -559,28 +564,42 @@ const List = () => {
         })
         .catch((err) => console.log(err))
 
+      const objectives: Record<string, Objective> = {}
       objectiveResponse.objectives.forEach((o: Objective) => {
+        objectives[labelsString(o.labels)] = o
         dispatchTable({
           type: TableActionType.SetObjective,
           lset: labelsString(o.labels),
           objective: o,
         })
+      })
+
+      // Query the statuses of all objectives at once instead of one request per objective.
+      client
+        .listObjectiveStatuses({expr: labelsString(filterLabels)})
+        .then((resp: ListObjectiveStatusesResponse) => {
+          resp.objectives.forEach((os: ObjectiveStatuses) => {
+            const lset = labelsString(os.labels)
+            const o = objectives[lset]
+            if (o === undefined) {
+              return
+            }
 
-        client
-          .getStatus({expr: labelsString(o.labels)})
-          .then((resp: GetStatusResponse) => {
-            if (resp.status.length === 0) {
-              dispatchTable({type: TableActionType.SetStatusNone, lset: labelsString(o.labels)})
-            } else if (resp.status.length === 1) {
+            if (os.error !== '') {
+              console.log(os.error)
+              dispatchTable({type: TableActionType.SetStatusError, lset})
+            } else if (os.status.length === 0) {
+              dispatchTable({type: TableActionType.SetStatusNone, lset})
+            } else if (os.status.length === 1) {
               dispatchTable({
                 type: TableActionType.SetStatus,
-                lset: labelsString(o.labels),
-                status: resp.status[0],
+                lset,
+                status: os.status[0],
               })
             } else {
-              dispatchTable({type: TableActionType.DeleteObjective, lset: labelsString(o.labels)})
+              dispatchTable({type: TableActionType.DeleteObjective, lset})
 
-              resp.status.forEach((s: ObjectiveStatus) => {
+              os.status.forEach((s: ObjectiveStatus) => {
                 const so = o.clone()
                 // Identify by the combined labels
                 const sLabels: Labels = s.labels !== undefined ? s.labels : {}
@@ -596,13 +615,15 @@ const List = () => {
               })
             }
           })
-          .catch((err) => {
-            console.log(err)
-            dispatchTable({type: TableActionType.SetStatusError, lset: labelsString(o.labels)})
+        })
+        .catch((err) => {
+          console.log(err)
+          Object.keys(objectives).forEach((lset: string) => {
+            dispatchTable({type: TableActionType.SetStatusError, lset})
           })
-      })
+        })
     }
-  }, [client, objectiveResponse, objectiveStatus])
+  }, [client, objectiveResponse, objectiveStatus, filterLabels])
 
   const reactTableData = useMemo(() => {
     if (Object.keys(table.objectives).length === 0) {
@@ -760,6 +781,15 @@ const List = () => {
             </Dropdown>
           </Col>
         </Row>
+        <Row>
+          <Col>
+            <QueryWarnings
+              warnings={Object.values(table.objectives).flatMap(
+                (o: TableObjective) => o.warnings ?? [],
+              )}
+            />
+          </Col>
+        </Row>
         <Row>
           <div className="table-responsive">
             <Table hover={true}>
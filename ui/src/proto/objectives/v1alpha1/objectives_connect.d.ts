@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetAlertsRequest, GetAlertsResponse, GetStatusRequest, GetStatusResponse, GraphDurationRequest, GraphDurationResponse, GraphErrorBudgetRequest, GraphErrorBudgetResponse, GraphErrorsRequest, GraphErrorsResponse, GraphRateRequest, GraphRateResponse, ListRequest, ListResponse } from "./objectives_pb.js";
+import { GetAlertRulesRequest, GetAlertRulesResponse, GetAlertsRequest, GetAlertsResponse, GetBudgetExhaustionRequest, GetBudgetExhaustionResponse, GetGroupingValuesRequest, GetGroupingValuesResponse, GetRulesRequest, GetRulesResponse, GetSnapshotRequest, GetSnapshotResponse, GetSourceRequest, GetSourceResponse, GetStatusRequest, GetStatusResponse, GraphAlertsRequest, GraphAlertsResponse, GraphDurationRequest, GraphDurationResponse, GraphErrorBudgetRequest, GraphErrorBudgetResponse, GraphErrorsRequest, GraphErrorsResponse, GraphRateRequest, GraphRateResponse, ListObjectiveStatusesRequest, ListObjectiveStatusesResponse, ListRequest, ListResponse } from "./objectives_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -30,6 +30,15 @@ export declare const ObjectiveService: {
       readonly O: typeof GetStatusResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.ListObjectiveStatuses
+     */
+    readonly listObjectiveStatuses: {
+      readonly name: "ListObjectiveStatuses",
+      readonly I: typeof ListObjectiveStatusesRequest,
+      readonly O: typeof ListObjectiveStatusesResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc objectives.v1alpha1.ObjectiveService.GetAlerts
      */
@@ -39,6 +48,33 @@ export declare const ObjectiveService: {
       readonly O: typeof GetAlertsResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetAlertRules
+     */
+    readonly getAlertRules: {
+      readonly name: "GetAlertRules",
+      readonly I: typeof GetAlertRulesRequest,
+      readonly O: typeof GetAlertRulesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetSource
+     */
+    readonly getSource: {
+      readonly name: "GetSource",
+      readonly I: typeof GetSourceRequest,
+      readonly O: typeof GetSourceResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetRules
+     */
+    readonly getRules: {
+      readonly name: "GetRules",
+      readonly I: typeof GetRulesRequest,
+      readonly O: typeof GetRulesResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc objectives.v1alpha1.ObjectiveService.GraphErrorBudget
      */
@@ -75,6 +111,42 @@ export declare const ObjectiveService: {
       readonly O: typeof GraphDurationResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GraphAlerts
+     */
+    readonly graphAlerts: {
+      readonly name: "GraphAlerts",
+      readonly I: typeof GraphAlertsRequest,
+      readonly O: typeof GraphAlertsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetBudgetExhaustion
+     */
+    readonly getBudgetExhaustion: {
+      readonly name: "GetBudgetExhaustion",
+      readonly I: typeof GetBudgetExhaustionRequest,
+      readonly O: typeof GetBudgetExhaustionResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetGroupingValues
+     */
+    readonly getGroupingValues: {
+      readonly name: "GetGroupingValues",
+      readonly I: typeof GetGroupingValuesRequest,
+      readonly O: typeof GetGroupingValuesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetSnapshot
+     */
+    readonly getSnapshot: {
+      readonly name: "GetSnapshot",
+      readonly I: typeof GetSnapshotRequest,
+      readonly O: typeof GetSnapshotResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetAlertsRequest, GetAlertsResponse, GetStatusRequest, GetStatusResponse, GraphDurationRequest, GraphDurationResponse, GraphErrorBudgetRequest, GraphErrorBudgetResponse, GraphErrorsRequest, GraphErrorsResponse, GraphRateRequest, GraphRateResponse, ListRequest, ListResponse } from "./objectives_pb.js";
+import { GetAlertRulesRequest, GetAlertRulesResponse, GetAlertsRequest, GetAlertsResponse, GetBudgetExhaustionRequest, GetBudgetExhaustionResponse, GetGroupingValuesRequest, GetGroupingValuesResponse, GetRulesRequest, GetRulesResponse, GetSnapshotRequest, GetSnapshotResponse, GetSourceRequest, GetSourceResponse, GetStatusRequest, GetStatusResponse, GraphAlertsRequest, GraphAlertsResponse, GraphDurationRequest, GraphDurationResponse, GraphErrorBudgetRequest, GraphErrorBudgetResponse, GraphErrorsRequest, GraphErrorsResponse, GraphRateRequest, GraphRateResponse, ListObjectiveStatusesRequest, ListObjectiveStatusesResponse, ListRequest, ListResponse } from "./objectives_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -30,6 +30,15 @@ export const ObjectiveService = {
       O: GetStatusResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.ListObjectiveStatuses
+     */
+    listObjectiveStatuses: {
+      name: "ListObjectiveStatuses",
+      I: ListObjectiveStatusesRequest,
+      O: ListObjectiveStatusesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc objectives.v1alpha1.ObjectiveService.GetAlerts
      */
@@ -39,6 +48,33 @@ export const ObjectiveService = {
       O: GetAlertsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetAlertRules
+     */
+    getAlertRules: {
+      name: "GetAlertRules",
+      I: GetAlertRulesRequest,
+      O: GetAlertRulesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetSource
+     */
+    getSource: {
+      name: "GetSource",
+      I: GetSourceRequest,
+      O: GetSourceResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetRules
+     */
+    getRules: {
+      name: "GetRules",
+      I: GetRulesRequest,
+      O: GetRulesResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc objectives.v1alpha1.ObjectiveService.GraphErrorBudget
      */
@@ -75,6 +111,42 @@ export const ObjectiveService = {
       O: GraphDurationResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GraphAlerts
+     */
+    graphAlerts: {
+      name: "GraphAlerts",
+      I: GraphAlertsRequest,
+      O: GraphAlertsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetBudgetExhaustion
+     */
+    getBudgetExhaustion: {
+      name: "GetBudgetExhaustion",
+      I: GetBudgetExhaustionRequest,
+      O: GetBudgetExhaustionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetGroupingValues
+     */
+    getGroupingValues: {
+      name: "GetGroupingValues",
+      I: GetGroupingValuesRequest,
+      O: GetGroupingValuesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc objectives.v1alpha1.ObjectiveService.GetSnapshot
+     */
+    getSnapshot: {
+      name: "GetSnapshot",
+      I: GetSnapshotRequest,
+      O: GetSnapshotResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -20,6 +20,41 @@ export declare class ListRequest extends Message<ListRequest> {
    */
   grouping: string;
 
+  /**
+   * sort_by sorts the objectives by either "name" (default) or "window".
+   *
+   * @generated from field: string sort_by = 3;
+   */
+  sortBy: string;
+
+  /**
+   * window only lists objectives with exactly this window, e.g. "28d".
+   *
+   * @generated from field: string window = 4;
+   */
+  window: string;
+
+  /**
+   * target_min and target_max only list objectives with a target within the
+   * inclusive range, e.g. 0.99. Zero leaves that side of the range unbounded.
+   *
+   * @generated from field: double target_min = 5;
+   */
+  targetMin: number;
+
+  /**
+   * @generated from field: double target_max = 6;
+   */
+  targetMax: number;
+
+  /**
+   * validate checks whether each objective's recording rules exist in Prometheus
+   * and sets the objectives' rules_state accordingly.
+   *
+   * @generated from field: bool validate = 7;
+   */
+  validate: boolean;
+
   constructor(data?: PartialMessage<ListRequest>);
 
   static readonly runtime: typeof proto3;
@@ -98,6 +133,11 @@ export declare class Objective extends Message<Objective> {
    */
   queries?: Queries;
 
+  /**
+   * @generated from field: objectives.v1alpha1.Objective.RulesState rules_state = 8;
+   */
+  rulesState: Objective_RulesState;
+
   constructor(data?: PartialMessage<Objective>);
 
   static readonly runtime: typeof proto3;
@@ -113,6 +153,28 @@ export declare class Objective extends Message<Objective> {
   static equals(a: Objective | PlainMessage<Objective> | undefined, b: Objective | PlainMessage<Objective> | undefined): boolean;
 }
 
+/**
+ * @generated from enum objectives.v1alpha1.Objective.RulesState
+ */
+export declare enum Objective_RulesState {
+  /**
+   * unvalidated objectives weren't checked, e.g. as validate wasn't requested or the check failed.
+   *
+   * @generated from enum value: unvalidated = 0;
+   */
+  unvalidated = 0,
+
+  /**
+   * @generated from enum value: configured = 1;
+   */
+  configured = 1,
+
+  /**
+   * @generated from enum value: missingRules = 2;
+   */
+  missingRules = 2,
+}
+
 /**
  * @generated from message objectives.v1alpha1.Indicator
  */
@@ -180,6 +242,11 @@ export declare class Ratio extends Message<Ratio> {
    */
   grouping: string[];
 
+  /**
+   * @generated from field: string function = 4;
+   */
+  function: string;
+
   constructor(data?: PartialMessage<Ratio>);
 
   static readonly runtime: typeof proto3;
@@ -214,6 +281,11 @@ export declare class Latency extends Message<Latency> {
    */
   grouping: string[];
 
+  /**
+   * @generated from field: string function = 4;
+   */
+  function: string;
+
   constructor(data?: PartialMessage<Latency>);
 
   static readonly runtime: typeof proto3;
@@ -442,15 +514,28 @@ export declare class GetStatusRequest extends Message<GetStatusRequest> {
   expr: string;
 
   /**
+   * grouping selects groups of the objective with matchers on its grouping labels, e.g. {job="api"}.
+   * All of =, !=, =~ and !~ are supported, {job=~"api-.*"} returns all matching groups.
+   *
    * @generated from field: string grouping = 2;
    */
   grouping: string;
 
   /**
+   * time to evaluate the status at, e.g. to look at it after an incident. Defaults to now, must not be in the future.
+   *
    * @generated from field: google.protobuf.Timestamp time = 3;
    */
   time?: Timestamp;
 
+  /**
+   * precision rounds the availability percentage and remaining error budget to this many decimal places,
+   * e.g. 4 returns 0.9995 instead of 0.9994999999. Zero doesn't round, at most 15 decimal places are supported.
+   *
+   * @generated from field: uint32 precision = 4;
+   */
+  precision: number;
+
   constructor(data?: PartialMessage<GetStatusRequest>);
 
   static readonly runtime: typeof proto3;
@@ -490,6 +575,107 @@ export declare class GetStatusResponse extends Message<GetStatusResponse> {
   static equals(a: GetStatusResponse | PlainMessage<GetStatusResponse> | undefined, b: GetStatusResponse | PlainMessage<GetStatusResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message objectives.v1alpha1.ListObjectiveStatusesRequest
+ */
+export declare class ListObjectiveStatusesRequest extends Message<ListObjectiveStatusesRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  /**
+   * time to evaluate the status at, e.g. to look at it after an incident. Defaults to now, must not be in the future.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * precision rounds the availability percentage and remaining error budget to this many decimal places,
+   * e.g. 4 returns 0.9995 instead of 0.9994999999. Zero doesn't round, at most 15 decimal places are supported.
+   *
+   * @generated from field: uint32 precision = 3;
+   */
+  precision: number;
+
+  constructor(data?: PartialMessage<ListObjectiveStatusesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.ListObjectiveStatusesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListObjectiveStatusesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListObjectiveStatusesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListObjectiveStatusesRequest;
+
+  static equals(a: ListObjectiveStatusesRequest | PlainMessage<ListObjectiveStatusesRequest> | undefined, b: ListObjectiveStatusesRequest | PlainMessage<ListObjectiveStatusesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.ListObjectiveStatusesResponse
+ */
+export declare class ListObjectiveStatusesResponse extends Message<ListObjectiveStatusesResponse> {
+  /**
+   * @generated from field: repeated objectives.v1alpha1.ObjectiveStatuses objectives = 1;
+   */
+  objectives: ObjectiveStatuses[];
+
+  constructor(data?: PartialMessage<ListObjectiveStatusesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.ListObjectiveStatusesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListObjectiveStatusesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListObjectiveStatusesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListObjectiveStatusesResponse;
+
+  static equals(a: ListObjectiveStatusesResponse | PlainMessage<ListObjectiveStatusesResponse> | undefined, b: ListObjectiveStatusesResponse | PlainMessage<ListObjectiveStatusesResponse> | undefined): boolean;
+}
+
+/**
+ * ObjectiveStatuses are the statuses of a single objective, one for each group if the objective is grouped.
+ *
+ * @generated from message objectives.v1alpha1.ObjectiveStatuses
+ */
+export declare class ObjectiveStatuses extends Message<ObjectiveStatuses> {
+  /**
+   * @generated from field: map<string, string> labels = 1;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * @generated from field: repeated objectives.v1alpha1.ObjectiveStatus status = 2;
+   */
+  status: ObjectiveStatus[];
+
+  /**
+   * error is set if the objective's status couldn't be queried, the other objectives are still returned.
+   *
+   * @generated from field: string error = 3;
+   */
+  error: string;
+
+  constructor(data?: PartialMessage<ObjectiveStatuses>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.ObjectiveStatuses";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObjectiveStatuses;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObjectiveStatuses;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObjectiveStatuses;
+
+  static equals(a: ObjectiveStatuses | PlainMessage<ObjectiveStatuses> | undefined, b: ObjectiveStatuses | PlainMessage<ObjectiveStatuses> | undefined): boolean;
+}
+
 /**
  * @generated from message objectives.v1alpha1.ObjectiveStatus
  */
@@ -509,6 +695,13 @@ export declare class ObjectiveStatus extends Message<ObjectiveStatus> {
    */
   budget?: Budget;
 
+  /**
+   * warnings returned by Prometheus, e.g. if the data might be incomplete.
+   *
+   * @generated from field: repeated string warnings = 4;
+   */
+  warnings: string[];
+
   constructor(data?: PartialMessage<ObjectiveStatus>);
 
   static readonly runtime: typeof proto3;
@@ -577,6 +770,13 @@ export declare class Budget extends Message<Budget> {
    */
   max: number;
 
+  /**
+   * allowed is the error budget as a duration of the objective's window, e.g. 43m12s for 99.9% over 30d.
+   *
+   * @generated from field: google.protobuf.Duration allowed = 4;
+   */
+  allowed?: Duration;
+
   constructor(data?: PartialMessage<Budget>);
 
   static readonly runtime: typeof proto3;
@@ -640,6 +840,13 @@ export declare class GetAlertsResponse extends Message<GetAlertsResponse> {
    */
   alerts: Alert[];
 
+  /**
+   * warnings returned by Prometheus, e.g. if the data might be incomplete.
+   *
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[];
+
   constructor(data?: PartialMessage<GetAlertsResponse>);
 
   static readonly runtime: typeof proto3;
@@ -694,6 +901,21 @@ export declare class Alert extends Message<Alert> {
    */
   long?: Burnrate;
 
+  /**
+   * threshold is the burn rate both windows have to exceed for the alert to fire.
+   *
+   * @generated from field: double threshold = 8;
+   */
+  threshold: number;
+
+  /**
+   * exceeding is true if both windows' current burn rates exceed the threshold.
+   * It's only computed if the current burn rates are requested.
+   *
+   * @generated from field: bool exceeding = 9;
+   */
+  exceeding: boolean;
+
   constructor(data?: PartialMessage<Alert>);
 
   static readonly runtime: typeof proto3;
@@ -729,6 +951,294 @@ export declare enum Alert_State {
   firing = 2,
 }
 
+/**
+ * @generated from message objectives.v1alpha1.GetAlertRulesRequest
+ */
+export declare class GetAlertRulesRequest extends Message<GetAlertRulesRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  constructor(data?: PartialMessage<GetAlertRulesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetAlertRulesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertRulesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertRulesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertRulesRequest;
+
+  static equals(a: GetAlertRulesRequest | PlainMessage<GetAlertRulesRequest> | undefined, b: GetAlertRulesRequest | PlainMessage<GetAlertRulesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetAlertRulesResponse
+ */
+export declare class GetAlertRulesResponse extends Message<GetAlertRulesResponse> {
+  /**
+   * @generated from field: repeated objectives.v1alpha1.AlertRule rules = 1;
+   */
+  rules: AlertRule[];
+
+  constructor(data?: PartialMessage<GetAlertRulesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetAlertRulesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAlertRulesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAlertRulesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAlertRulesResponse;
+
+  static equals(a: GetAlertRulesResponse | PlainMessage<GetAlertRulesResponse> | undefined, b: GetAlertRulesResponse | PlainMessage<GetAlertRulesResponse> | undefined): boolean;
+}
+
+/**
+ * AlertRule is a multi burn rate alert as generated for an objective.
+ * It is computed without querying Prometheus, the burn rates' current values are never set.
+ *
+ * @generated from message objectives.v1alpha1.AlertRule
+ */
+export declare class AlertRule extends Message<AlertRule> {
+  /**
+   * @generated from field: string severity = 1;
+   */
+  severity: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration for = 2;
+   */
+  for?: Duration;
+
+  /**
+   * @generated from field: double factor = 3;
+   */
+  factor: number;
+
+  /**
+   * threshold is the burn rate both windows have to exceed for the alert to fire.
+   *
+   * @generated from field: double threshold = 4;
+   */
+  threshold: number;
+
+  /**
+   * @generated from field: objectives.v1alpha1.Burnrate short = 5;
+   */
+  short?: Burnrate;
+
+  /**
+   * @generated from field: objectives.v1alpha1.Burnrate long = 6;
+   */
+  long?: Burnrate;
+
+  constructor(data?: PartialMessage<AlertRule>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.AlertRule";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AlertRule;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AlertRule;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AlertRule;
+
+  static equals(a: AlertRule | PlainMessage<AlertRule> | undefined, b: AlertRule | PlainMessage<AlertRule> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetSourceRequest
+ */
+export declare class GetSourceRequest extends Message<GetSourceRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  constructor(data?: PartialMessage<GetSourceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetSourceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSourceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSourceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSourceRequest;
+
+  static equals(a: GetSourceRequest | PlainMessage<GetSourceRequest> | undefined, b: GetSourceRequest | PlainMessage<GetSourceRequest> | undefined): boolean;
+}
+
+/**
+ * GetSourceResponse contains the objective's original definition,
+ * the YAML file for the filesystem backend and the ServiceLevelObjective resource for Kubernetes.
+ *
+ * @generated from message objectives.v1alpha1.GetSourceResponse
+ */
+export declare class GetSourceResponse extends Message<GetSourceResponse> {
+  /**
+   * @generated from field: string source = 1;
+   */
+  source: string;
+
+  constructor(data?: PartialMessage<GetSourceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetSourceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSourceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSourceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSourceResponse;
+
+  static equals(a: GetSourceResponse | PlainMessage<GetSourceResponse> | undefined, b: GetSourceResponse | PlainMessage<GetSourceResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetRulesRequest
+ */
+export declare class GetRulesRequest extends Message<GetRulesRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  constructor(data?: PartialMessage<GetRulesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetRulesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRulesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRulesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRulesRequest;
+
+  static equals(a: GetRulesRequest | PlainMessage<GetRulesRequest> | undefined, b: GetRulesRequest | PlainMessage<GetRulesRequest> | undefined): boolean;
+}
+
+/**
+ * GetRulesResponse contains the recording and alerting rules generated for an objective.
+ *
+ * @generated from message objectives.v1alpha1.GetRulesResponse
+ */
+export declare class GetRulesResponse extends Message<GetRulesResponse> {
+  /**
+   * @generated from field: repeated objectives.v1alpha1.RuleGroup groups = 1;
+   */
+  groups: RuleGroup[];
+
+  constructor(data?: PartialMessage<GetRulesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetRulesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRulesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRulesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRulesResponse;
+
+  static equals(a: GetRulesResponse | PlainMessage<GetRulesResponse> | undefined, b: GetRulesResponse | PlainMessage<GetRulesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.RuleGroup
+ */
+export declare class RuleGroup extends Message<RuleGroup> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string interval = 2;
+   */
+  interval: string;
+
+  /**
+   * @generated from field: repeated objectives.v1alpha1.Rule rules = 3;
+   */
+  rules: Rule[];
+
+  constructor(data?: PartialMessage<RuleGroup>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.RuleGroup";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RuleGroup;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RuleGroup;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RuleGroup;
+
+  static equals(a: RuleGroup | PlainMessage<RuleGroup> | undefined, b: RuleGroup | PlainMessage<RuleGroup> | undefined): boolean;
+}
+
+/**
+ * Rule is either a recording rule with record set or an alerting rule with alert set.
+ *
+ * @generated from message objectives.v1alpha1.Rule
+ */
+export declare class Rule extends Message<Rule> {
+  /**
+   * @generated from field: string record = 1;
+   */
+  record: string;
+
+  /**
+   * @generated from field: string alert = 2;
+   */
+  alert: string;
+
+  /**
+   * @generated from field: string expr = 3;
+   */
+  expr: string;
+
+  /**
+   * @generated from field: string for = 4;
+   */
+  for: string;
+
+  /**
+   * @generated from field: map<string, string> labels = 5;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * @generated from field: map<string, string> annotations = 6;
+   */
+  annotations: { [key: string]: string };
+
+  constructor(data?: PartialMessage<Rule>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.Rule";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Rule;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Rule;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Rule;
+
+  static equals(a: Rule | PlainMessage<Rule> | undefined, b: Rule | PlainMessage<Rule> | undefined): boolean;
+}
+
 /**
  * @generated from message objectives.v1alpha1.Burnrate
  */
@@ -739,15 +1249,24 @@ export declare class Burnrate extends Message<Burnrate> {
   window?: Duration;
 
   /**
-   * @generated from field: double current = 2;
+   * current is the current burn rate. It's unset if unknown, e.g. without any data or if error is set.
+   *
+   * @generated from field: optional double current = 2;
    */
-  current: number;
+  current?: number;
 
   /**
    * @generated from field: string query = 3;
    */
   query: string;
 
+  /**
+   * error is set if querying the current burn rate failed.
+   *
+   * @generated from field: string error = 4;
+   */
+  error: string;
+
   constructor(data?: PartialMessage<Burnrate>);
 
   static readonly runtime: typeof proto3;
@@ -787,6 +1306,13 @@ export declare class GraphErrorBudgetRequest extends Message<GraphErrorBudgetReq
    */
   end?: Timestamp;
 
+  /**
+   * window to calculate the error budget over instead of the objective's window.
+   *
+   * @generated from field: string window = 5;
+   */
+  window: string;
+
   constructor(data?: PartialMessage<GraphErrorBudgetRequest>);
 
   static readonly runtime: typeof proto3;
@@ -836,6 +1362,8 @@ export declare class GraphRateRequest extends Message<GraphRateRequest> {
   expr: string;
 
   /**
+   * grouping selects groups of the objective, see GetStatusRequest.
+   *
    * @generated from field: string grouping = 2;
    */
   grouping: string;
@@ -850,6 +1378,14 @@ export declare class GraphRateRequest extends Message<GraphRateRequest> {
    */
   end?: Timestamp;
 
+  /**
+   * aggregate optionally aggregates the request series by the objective's grouping labels
+   * into a single series per group. Supported are sum and avg.
+   *
+   * @generated from field: string aggregate = 5;
+   */
+  aggregate: string;
+
   constructor(data?: PartialMessage<GraphRateRequest>);
 
   static readonly runtime: typeof proto3;
@@ -899,6 +1435,8 @@ export declare class GraphErrorsRequest extends Message<GraphErrorsRequest> {
   expr: string;
 
   /**
+   * grouping selects groups of the objective, see GetStatusRequest.
+   *
    * @generated from field: string grouping = 2;
    */
   grouping: string;
@@ -971,6 +1509,20 @@ export declare class Timeseries extends Message<Timeseries> {
    */
   series: Series[];
 
+  /**
+   * step is the resolution the series were queried with.
+   *
+   * @generated from field: google.protobuf.Duration step = 4;
+   */
+  step?: Duration;
+
+  /**
+   * warnings returned by Prometheus, e.g. if the data might be incomplete.
+   *
+   * @generated from field: repeated string warnings = 5;
+   */
+  warnings: string[];
+
   constructor(data?: PartialMessage<Timeseries>);
 
   static readonly runtime: typeof proto3;
@@ -991,6 +1543,8 @@ export declare class Timeseries extends Message<Timeseries> {
  */
 export declare class Series extends Message<Series> {
   /**
+   * values are NaN where there is no data, e.g. for gaps in the series.
+   *
    * @generated from field: repeated double values = 1;
    */
   values: number[];
@@ -1020,6 +1574,8 @@ export declare class GraphDurationRequest extends Message<GraphDurationRequest>
   expr: string;
 
   /**
+   * grouping selects groups of the objective, see GetStatusRequest.
+   *
    * @generated from field: string grouping = 2;
    */
   grouping: string;
@@ -1034,6 +1590,14 @@ export declare class GraphDurationRequest extends Message<GraphDurationRequest>
    */
   end?: Timestamp;
 
+  /**
+   * percentiles to query, each between 0 and 1.
+   * Defaults to the common percentiles below the objective's target.
+   *
+   * @generated from field: repeated double percentiles = 5;
+   */
+  percentiles: number[];
+
   constructor(data?: PartialMessage<GraphDurationRequest>);
 
   static readonly runtime: typeof proto3;
@@ -1073,3 +1637,311 @@ export declare class GraphDurationResponse extends Message<GraphDurationResponse
   static equals(a: GraphDurationResponse | PlainMessage<GraphDurationResponse> | undefined, b: GraphDurationResponse | PlainMessage<GraphDurationResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message objectives.v1alpha1.GraphAlertsRequest
+ */
+export declare class GraphAlertsRequest extends Message<GraphAlertsRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  /**
+   * @generated from field: string grouping = 2;
+   */
+  grouping: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start = 3;
+   */
+  start?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp end = 4;
+   */
+  end?: Timestamp;
+
+  constructor(data?: PartialMessage<GraphAlertsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GraphAlertsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GraphAlertsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GraphAlertsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GraphAlertsRequest;
+
+  static equals(a: GraphAlertsRequest | PlainMessage<GraphAlertsRequest> | undefined, b: GraphAlertsRequest | PlainMessage<GraphAlertsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GraphAlertsResponse
+ */
+export declare class GraphAlertsResponse extends Message<GraphAlertsResponse> {
+  /**
+   * timeseries has a series per multi burn rate alert, labeled by its short and long window and severity.
+   * The values are 1 while the alert is pending, 2 while it is firing and NaN while it is inactive.
+   *
+   * @generated from field: objectives.v1alpha1.Timeseries timeseries = 1;
+   */
+  timeseries?: Timeseries;
+
+  constructor(data?: PartialMessage<GraphAlertsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GraphAlertsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GraphAlertsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GraphAlertsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GraphAlertsResponse;
+
+  static equals(a: GraphAlertsResponse | PlainMessage<GraphAlertsResponse> | undefined, b: GraphAlertsResponse | PlainMessage<GraphAlertsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetBudgetExhaustionRequest
+ */
+export declare class GetBudgetExhaustionRequest extends Message<GetBudgetExhaustionRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  /**
+   * @generated from field: string grouping = 2;
+   */
+  grouping: string;
+
+  constructor(data?: PartialMessage<GetBudgetExhaustionRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetBudgetExhaustionRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBudgetExhaustionRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBudgetExhaustionRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBudgetExhaustionRequest;
+
+  static equals(a: GetBudgetExhaustionRequest | PlainMessage<GetBudgetExhaustionRequest> | undefined, b: GetBudgetExhaustionRequest | PlainMessage<GetBudgetExhaustionRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetBudgetExhaustionResponse
+ */
+export declare class GetBudgetExhaustionResponse extends Message<GetBudgetExhaustionResponse> {
+  /**
+   * @generated from field: repeated objectives.v1alpha1.BudgetExhaustion exhaustion = 1;
+   */
+  exhaustion: BudgetExhaustion[];
+
+  constructor(data?: PartialMessage<GetBudgetExhaustionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetBudgetExhaustionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetBudgetExhaustionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetBudgetExhaustionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetBudgetExhaustionResponse;
+
+  static equals(a: GetBudgetExhaustionResponse | PlainMessage<GetBudgetExhaustionResponse> | undefined, b: GetBudgetExhaustionResponse | PlainMessage<GetBudgetExhaustionResponse> | undefined): boolean;
+}
+
+/**
+ * BudgetExhaustion projects when the error budget is exhausted if it keeps burning at the current burn rate.
+ *
+ * @generated from message objectives.v1alpha1.BudgetExhaustion
+ */
+export declare class BudgetExhaustion extends Message<BudgetExhaustion> {
+  /**
+   * @generated from field: map<string, string> labels = 1;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * remaining is the fraction of the error budget left, like the status' remaining budget.
+   *
+   * @generated from field: double remaining = 2;
+   */
+  remaining: number;
+
+  /**
+   * burnrate is the current burn rate over the objective's shortest long alerting window.
+   * Its current value is unset if unknown, e.g. without any traffic.
+   *
+   * @generated from field: objectives.v1alpha1.Burnrate burnrate = 3;
+   */
+  burnrate?: Burnrate;
+
+  /**
+   * exhausted is when the remaining error budget runs out at the current burn rate.
+   * It's unset if the error budget isn't burning.
+   *
+   * @generated from field: google.protobuf.Timestamp exhausted = 4;
+   */
+  exhausted?: Timestamp;
+
+  constructor(data?: PartialMessage<BudgetExhaustion>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.BudgetExhaustion";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BudgetExhaustion;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BudgetExhaustion;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BudgetExhaustion;
+
+  static equals(a: BudgetExhaustion | PlainMessage<BudgetExhaustion> | undefined, b: BudgetExhaustion | PlainMessage<BudgetExhaustion> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetGroupingValuesRequest
+ */
+export declare class GetGroupingValuesRequest extends Message<GetGroupingValuesRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  /**
+   * label is the grouping label to get the values of, e.g. job. It must be one of the objective's grouping labels.
+   *
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  constructor(data?: PartialMessage<GetGroupingValuesRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetGroupingValuesRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGroupingValuesRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGroupingValuesRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGroupingValuesRequest;
+
+  static equals(a: GetGroupingValuesRequest | PlainMessage<GetGroupingValuesRequest> | undefined, b: GetGroupingValuesRequest | PlainMessage<GetGroupingValuesRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetGroupingValuesResponse
+ */
+export declare class GetGroupingValuesResponse extends Message<GetGroupingValuesResponse> {
+  /**
+   * values are the distinct values of the grouping label in the objective's total metric, sorted.
+   *
+   * @generated from field: repeated string values = 1;
+   */
+  values: string[];
+
+  constructor(data?: PartialMessage<GetGroupingValuesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetGroupingValuesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGroupingValuesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGroupingValuesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGroupingValuesResponse;
+
+  static equals(a: GetGroupingValuesResponse | PlainMessage<GetGroupingValuesResponse> | undefined, b: GetGroupingValuesResponse | PlainMessage<GetGroupingValuesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message objectives.v1alpha1.GetSnapshotRequest
+ */
+export declare class GetSnapshotRequest extends Message<GetSnapshotRequest> {
+  /**
+   * @generated from field: string expr = 1;
+   */
+  expr: string;
+
+  constructor(data?: PartialMessage<GetSnapshotRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetSnapshotRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSnapshotRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSnapshotRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSnapshotRequest;
+
+  static equals(a: GetSnapshotRequest | PlainMessage<GetSnapshotRequest> | undefined, b: GetSnapshotRequest | PlainMessage<GetSnapshotRequest> | undefined): boolean;
+}
+
+/**
+ * GetSnapshotResponse bundles an objective's state at a single point in time,
+ * e.g. to attach it to an incident ticket.
+ *
+ * @generated from message objectives.v1alpha1.GetSnapshotResponse
+ */
+export declare class GetSnapshotResponse extends Message<GetSnapshotResponse> {
+  /**
+   * @generated from field: objectives.v1alpha1.Objective objective = 1;
+   */
+  objective?: Objective;
+
+  /**
+   * time the status was evaluated at, the error budget ends at it.
+   *
+   * @generated from field: google.protobuf.Timestamp time = 2;
+   */
+  time?: Timestamp;
+
+  /**
+   * @generated from field: repeated objectives.v1alpha1.ObjectiveStatus status = 3;
+   */
+  status: ObjectiveStatus[];
+
+  /**
+   * error_budget over the default error budget range up to time.
+   *
+   * @generated from field: objectives.v1alpha1.Timeseries error_budget = 4;
+   */
+  errorBudget?: Timeseries;
+
+  /**
+   * alerts are all multi burn rate alerts, including inactive ones, with their current burn rates.
+   *
+   * @generated from field: repeated objectives.v1alpha1.Alert alerts = 5;
+   */
+  alerts: Alert[];
+
+  /**
+   * warnings returned by Prometheus, e.g. if the data might be incomplete.
+   *
+   * @generated from field: repeated string warnings = 6;
+   */
+  warnings: string[];
+
+  constructor(data?: PartialMessage<GetSnapshotResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "objectives.v1alpha1.GetSnapshotResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSnapshotResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSnapshotResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSnapshotResponse;
+
+  static equals(a: GetSnapshotResponse | PlainMessage<GetSnapshotResponse> | undefined, b: GetSnapshotResponse | PlainMessage<GetSnapshotResponse> | undefined): boolean;
+}
@@ -13,6 +13,11 @@ export const ListRequest = proto3.makeMessageType(
   () => [
     { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "sort_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "window", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "target_min", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "target_max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "validate", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -39,6 +44,19 @@ export const Objective = proto3.makeMessageType(
     { no: 5, name: "indicator", kind: "message", T: Indicator },
     { no: 6, name: "config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "queries", kind: "message", T: Queries },
+    { no: 8, name: "rules_state", kind: "enum", T: proto3.getEnumType(Objective_RulesState) },
+  ],
+);
+
+/**
+ * @generated from enum objectives.v1alpha1.Objective.RulesState
+ */
+export const Objective_RulesState = proto3.makeEnum(
+  "objectives.v1alpha1.Objective.RulesState",
+  [
+    {no: 0, name: "unvalidated"},
+    {no: 1, name: "configured"},
+    {no: 2, name: "missingRules"},
   ],
 );
 
@@ -64,6 +82,7 @@ export const Ratio = proto3.makeMessageType(
     { no: 1, name: "total", kind: "message", T: Query },
     { no: 2, name: "errors", kind: "message", T: Query },
     { no: 3, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "function", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -76,6 +95,7 @@ export const Latency = proto3.makeMessageType(
     { no: 1, name: "total", kind: "message", T: Query },
     { no: 2, name: "success", kind: "message", T: Query },
     { no: 3, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "function", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -165,6 +185,7 @@ export const GetStatusRequest = proto3.makeMessageType(
     { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "time", kind: "message", T: Timestamp },
+    { no: 4, name: "precision", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ],
 );
 
@@ -178,6 +199,42 @@ export const GetStatusResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message objectives.v1alpha1.ListObjectiveStatusesRequest
+ */
+export const ListObjectiveStatusesRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.ListObjectiveStatusesRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "time", kind: "message", T: Timestamp },
+    { no: 3, name: "precision", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.ListObjectiveStatusesResponse
+ */
+export const ListObjectiveStatusesResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.ListObjectiveStatusesResponse",
+  () => [
+    { no: 1, name: "objectives", kind: "message", T: ObjectiveStatuses, repeated: true },
+  ],
+);
+
+/**
+ * ObjectiveStatuses are the statuses of a single objective, one for each group if the objective is grouped.
+ *
+ * @generated from message objectives.v1alpha1.ObjectiveStatuses
+ */
+export const ObjectiveStatuses = proto3.makeMessageType(
+  "objectives.v1alpha1.ObjectiveStatuses",
+  () => [
+    { no: 1, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 2, name: "status", kind: "message", T: ObjectiveStatus, repeated: true },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * @generated from message objectives.v1alpha1.ObjectiveStatus
  */
@@ -187,6 +244,7 @@ export const ObjectiveStatus = proto3.makeMessageType(
     { no: 1, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 2, name: "availability", kind: "message", T: Availability },
     { no: 3, name: "budget", kind: "message", T: Budget },
+    { no: 4, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ],
 );
 
@@ -211,6 +269,7 @@ export const Budget = proto3.makeMessageType(
     { no: 1, name: "total", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
     { no: 2, name: "remaining", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
     { no: 3, name: "max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "allowed", kind: "message", T: Duration },
   ],
 );
 
@@ -234,6 +293,7 @@ export const GetAlertsResponse = proto3.makeMessageType(
   "objectives.v1alpha1.GetAlertsResponse",
   () => [
     { no: 1, name: "alerts", kind: "message", T: Alert, repeated: true },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ],
 );
 
@@ -250,6 +310,8 @@ export const Alert = proto3.makeMessageType(
     { no: 5, name: "state", kind: "enum", T: proto3.getEnumType(Alert_State) },
     { no: 6, name: "short", kind: "message", T: Burnrate },
     { no: 7, name: "long", kind: "message", T: Burnrate },
+    { no: 8, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 9, name: "exceeding", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -265,6 +327,118 @@ export const Alert_State = proto3.makeEnum(
   ],
 );
 
+/**
+ * @generated from message objectives.v1alpha1.GetAlertRulesRequest
+ */
+export const GetAlertRulesRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GetAlertRulesRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetAlertRulesResponse
+ */
+export const GetAlertRulesResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GetAlertRulesResponse",
+  () => [
+    { no: 1, name: "rules", kind: "message", T: AlertRule, repeated: true },
+  ],
+);
+
+/**
+ * AlertRule is a multi burn rate alert as generated for an objective.
+ * It is computed without querying Prometheus, the burn rates' current values are never set.
+ *
+ * @generated from message objectives.v1alpha1.AlertRule
+ */
+export const AlertRule = proto3.makeMessageType(
+  "objectives.v1alpha1.AlertRule",
+  () => [
+    { no: 1, name: "severity", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "for", kind: "message", T: Duration },
+    { no: 3, name: "factor", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "threshold", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "short", kind: "message", T: Burnrate },
+    { no: 6, name: "long", kind: "message", T: Burnrate },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetSourceRequest
+ */
+export const GetSourceRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GetSourceRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetSourceResponse contains the objective's original definition,
+ * the YAML file for the filesystem backend and the ServiceLevelObjective resource for Kubernetes.
+ *
+ * @generated from message objectives.v1alpha1.GetSourceResponse
+ */
+export const GetSourceResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GetSourceResponse",
+  () => [
+    { no: 1, name: "source", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetRulesRequest
+ */
+export const GetRulesRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GetRulesRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetRulesResponse contains the recording and alerting rules generated for an objective.
+ *
+ * @generated from message objectives.v1alpha1.GetRulesResponse
+ */
+export const GetRulesResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GetRulesResponse",
+  () => [
+    { no: 1, name: "groups", kind: "message", T: RuleGroup, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.RuleGroup
+ */
+export const RuleGroup = proto3.makeMessageType(
+  "objectives.v1alpha1.RuleGroup",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "interval", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rules", kind: "message", T: Rule, repeated: true },
+  ],
+);
+
+/**
+ * Rule is either a recording rule with record set or an alerting rule with alert set.
+ *
+ * @generated from message objectives.v1alpha1.Rule
+ */
+export const Rule = proto3.makeMessageType(
+  "objectives.v1alpha1.Rule",
+  () => [
+    { no: 1, name: "record", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "alert", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "for", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 6, name: "annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ],
+);
+
 /**
  * @generated from message objectives.v1alpha1.Burnrate
  */
@@ -272,8 +446,9 @@ export const Burnrate = proto3.makeMessageType(
   "objectives.v1alpha1.Burnrate",
   () => [
     { no: 1, name: "window", kind: "message", T: Duration },
-    { no: 2, name: "current", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 2, name: "current", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
     { no: 3, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -287,6 +462,7 @@ export const GraphErrorBudgetRequest = proto3.makeMessageType(
     { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "start", kind: "message", T: Timestamp },
     { no: 4, name: "end", kind: "message", T: Timestamp },
+    { no: 5, name: "window", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -310,6 +486,7 @@ export const GraphRateRequest = proto3.makeMessageType(
     { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "start", kind: "message", T: Timestamp },
     { no: 4, name: "end", kind: "message", T: Timestamp },
+    { no: 5, name: "aggregate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -355,6 +532,8 @@ export const Timeseries = proto3.makeMessageType(
     { no: 1, name: "labels", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 2, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "series", kind: "message", T: Series, repeated: true },
+    { no: 4, name: "step", kind: "message", T: Duration },
+    { no: 5, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ],
 );
 
@@ -378,6 +557,7 @@ export const GraphDurationRequest = proto3.makeMessageType(
     { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "start", kind: "message", T: Timestamp },
     { no: 4, name: "end", kind: "message", T: Timestamp },
+    { no: 5, name: "percentiles", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, repeated: true },
   ],
 );
 
@@ -391,3 +571,110 @@ export const GraphDurationResponse = proto3.makeMessageType(
   ],
 );
 
+/**
+ * @generated from message objectives.v1alpha1.GraphAlertsRequest
+ */
+export const GraphAlertsRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GraphAlertsRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start", kind: "message", T: Timestamp },
+    { no: 4, name: "end", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GraphAlertsResponse
+ */
+export const GraphAlertsResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GraphAlertsResponse",
+  () => [
+    { no: 1, name: "timeseries", kind: "message", T: Timeseries },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetBudgetExhaustionRequest
+ */
+export const GetBudgetExhaustionRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GetBudgetExhaustionRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "grouping", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetBudgetExhaustionResponse
+ */
+export const GetBudgetExhaustionResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GetBudgetExhaustionResponse",
+  () => [
+    { no: 1, name: "exhaustion", kind: "message", T: BudgetExhaustion, repeated: true },
+  ],
+);
+
+/**
+ * BudgetExhaustion projects when the error budget is exhausted if it keeps burning at the current burn rate.
+ *
+ * @generated from message objectives.v1alpha1.BudgetExhaustion
+ */
+export const BudgetExhaustion = proto3.makeMessageType(
+  "objectives.v1alpha1.BudgetExhaustion",
+  () => [
+    { no: 1, name: "labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 2, name: "remaining", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "burnrate", kind: "message", T: Burnrate },
+    { no: 4, name: "exhausted", kind: "message", T: Timestamp },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetGroupingValuesRequest
+ */
+export const GetGroupingValuesRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GetGroupingValuesRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetGroupingValuesResponse
+ */
+export const GetGroupingValuesResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GetGroupingValuesResponse",
+  () => [
+    { no: 1, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message objectives.v1alpha1.GetSnapshotRequest
+ */
+export const GetSnapshotRequest = proto3.makeMessageType(
+  "objectives.v1alpha1.GetSnapshotRequest",
+  () => [
+    { no: 1, name: "expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * GetSnapshotResponse bundles an objective's state at a single point in time,
+ * e.g. to attach it to an incident ticket.
+ *
+ * @generated from message objectives.v1alpha1.GetSnapshotResponse
+ */
+export const GetSnapshotResponse = proto3.makeMessageType(
+  "objectives.v1alpha1.GetSnapshotResponse",
+  () => [
+    { no: 1, name: "objective", kind: "message", T: Objective },
+    { no: 2, name: "time", kind: "message", T: Timestamp },
+    { no: 3, name: "status", kind: "message", T: ObjectiveStatus, repeated: true },
+    { no: 4, name: "error_budget", kind: "message", T: Timeseries },
+    { no: 5, name: "alerts", kind: "message", T: Alert, repeated: true },
+    { no: 6, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
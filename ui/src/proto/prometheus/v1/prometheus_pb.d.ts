@@ -112,6 +112,14 @@ export declare class QueryRangeRequest extends Message<QueryRangeRequest> {
    */
   step: bigint;
 
+  /**
+   * compare queries both the primary and the secondary Prometheus and returns the series of both,
+   * labeled with pyrra_prometheus="primary" or "secondary". Requires --prometheus-url-secondary.
+   *
+   * @generated from field: bool compare = 5;
+   */
+  compare: boolean;
+
   constructor(data?: PartialMessage<QueryRangeRequest>);
 
   static readonly runtime: typeof proto3;
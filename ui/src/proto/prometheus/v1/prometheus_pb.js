@@ -40,6 +40,7 @@ export const QueryRangeRequest = proto3.makeMessageType(
     { no: 2, name: "start", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 3, name: "end", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 4, name: "step", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "compare", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 